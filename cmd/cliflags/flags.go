@@ -3,28 +3,72 @@ package cliflags
 const (
 	BaseURIDefault = "https://app.launchdarkly.com"
 
-	AccessTokenFlag = "access-token"
-	AnalyticsOptOut = "analytics-opt-out"
-	BaseURIFlag     = "base-uri"
-	DataFlag        = "data"
-	EmailsFlag      = "emails"
-	EnvironmentFlag = "environment"
-	FlagFlag        = "flag"
-	OutputFlag      = "output"
-	ProjectFlag     = "project"
-	RoleFlag        = "role"
+	AccessTokenFlag       = "access-token"
+	AllFlag               = "all"
+	AllFlagsFlag          = "all-flags"
+	AnalyticsOptOut       = "analytics-opt-out"
+	BaseURIFlag           = "base-uri"
+	ColumnsFlag           = "columns"
+	CommentFlag           = "comment"
+	ContextFlag           = "context"
+	CredentialHelper      = "credential-helper"
+	CredentialStore       = "credential-store"
+	DataFlag              = "data"
+	DirFlag               = "dir"
+	DryRunFlag            = "dry-run"
+	EmailsFlag            = "emails"
+	EnvironmentFlag       = "environment"
+	FilterEnvironmentFlag = "filter-environment"
+	FlagFlag              = "flag"
+	FormatFlag            = "format"
+	FromFlag              = "from"
+	FromProjectFlag       = "from-project"
+	IncludeFlag           = "include"
+	MaxRetriesFlag        = "max-retries"
+	NewKeyFlag            = "new-key"
+	OlderThanFlag         = "older-than"
+	OutputFlag            = "output"
+	PrintCurlFlag         = "print-curl"
+	ProfileFlag           = "profile"
+	ProjectFlag           = "project"
+	QueryFlag             = "query"
+	RoleFlag              = "role"
+	SkipValidationFlag    = "skip-validation"
+	TimeoutFlag           = "timeout"
+	ToFlag                = "to"
+	ToProjectFlag         = "to-project"
+	VerboseFlag           = "verbose"
+	YesFlag               = "yes"
 
-	AccessTokenFlagDescription = "LaunchDarkly access token with write-level access"
-	AnalyticsOptOutDescription = "Opt out of analytics tracking"
-	BaseURIFlagDescription     = "LaunchDarkly base URI"
-	OutputFlagDescription      = "Command response output format in either JSON or plain text"
+	AccessTokenFlagDescription  = "LaunchDarkly access token with write-level access"
+	AnalyticsOptOutDescription  = "Opt out of analytics tracking"
+	BaseURIFlagDescription      = "LaunchDarkly base URI"
+	ColumnsFlagDescription      = "Comma-separated list of fields to show as columns in table output"
+	CredentialHelperDescription = "Command that prints the access token, such as a password manager's CLI"
+	CredentialStoreDescription  = "Where to save the access token: config for the config file, keyring for the OS keyring, or file for a file encrypted with LD_KEYRING_PASSWORD"
+	DryRunFlagDescription       = "Print the request instead of sending it"
+	EnvironmentFlagDescription  = "Default environment key for commands with an environment flag"
+	MaxRetriesFlagDescription   = "Maximum number of times to retry a request that was rate limited or failed because of a server error"
+	OutputFlagDescription       = "Command response output format in either JSON, YAML, plain text, a table, or template=<Go template>"
+	PrintCurlFlagDescription    = "Print a curl command for the request instead of sending it"
+	ProfileFlagDescription      = "Name of the profile in the config file to use. Can also be set with LD_PROFILE"
+	ProjectFlagDescription      = "Default project key for commands with a project flag"
+	QueryFlagDescription        = "JMESPath expression for the part of the response to output, such as environments.production.on. Quote keys with dashes, such as environments.\"my-env\".on"
+	TimeoutFlagDescription      = "Maximum time to wait for each request, such as 30s or 2m"
+	VerboseFlagDescription      = "Log each request to stderr. Use -vv to also log headers and bodies. Can also be set with LD_DEBUG"
 )
 
 func AllFlagsHelp() map[string]string {
 	return map[string]string{
-		AccessTokenFlag: AccessTokenFlagDescription,
-		AnalyticsOptOut: AnalyticsOptOutDescription,
-		BaseURIFlag:     BaseURIFlagDescription,
-		OutputFlag:      OutputFlagDescription,
+		AccessTokenFlag:  AccessTokenFlagDescription,
+		AnalyticsOptOut:  AnalyticsOptOutDescription,
+		BaseURIFlag:      BaseURIFlagDescription,
+		CredentialHelper: CredentialHelperDescription,
+		CredentialStore:  CredentialStoreDescription,
+		EnvironmentFlag:  EnvironmentFlagDescription,
+		MaxRetriesFlag:   MaxRetriesFlagDescription,
+		OutputFlag:       OutputFlagDescription,
+		ProjectFlag:      ProjectFlagDescription,
+		TimeoutFlag:      TimeoutFlagDescription,
 	}
 }
@@ -2,7 +2,9 @@ package config
 
 import (
 	"encoding/json"
+	goerrors "errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
@@ -19,9 +21,11 @@ import (
 )
 
 const (
-	ListFlag  = "list"
-	SetFlag   = "set"
-	UnsetFlag = "unset"
+	GetFlag      = "get"
+	ListFlag     = "list"
+	SetFlag      = "set"
+	UnsetFlag    = "unset"
+	ValidateFlag = "validate"
 )
 
 type ConfigCmd struct {
@@ -68,7 +72,13 @@ func NewConfigCmd(analyticsTrackerFn analytics.TrackerFn) *ConfigCmd {
 			cliflags.AccessTokenFlag,
 			cliflags.AnalyticsOptOut,
 			cliflags.BaseURIFlag,
+			cliflags.CredentialHelper,
+			cliflags.CredentialStore,
+			cliflags.EnvironmentFlag,
+			cliflags.MaxRetriesFlag,
 			cliflags.OutputFlag,
+			cliflags.ProjectFlag,
+			cliflags.TimeoutFlag,
 		} {
 			sb.WriteString(fmt.Sprintf("- `%s`: %s\n", s, cliflags.AllFlagsHelp()[s]))
 		}
@@ -89,12 +99,16 @@ func NewConfigCmd(analyticsTrackerFn analytics.TrackerFn) *ConfigCmd {
 		helpFun(cmd, args)
 	})
 
+	cmd.Flags().String(GetFlag, "", "Get the value of a config field")
+	_ = viper.BindPFlag(GetFlag, cmd.Flags().Lookup(GetFlag))
 	cmd.Flags().Bool(ListFlag, false, "List configs")
 	_ = viper.BindPFlag(ListFlag, cmd.Flags().Lookup(ListFlag))
 	cmd.Flags().Bool(SetFlag, false, "Set a config field to a value")
 	_ = viper.BindPFlag(SetFlag, cmd.Flags().Lookup(SetFlag))
 	cmd.Flags().String(UnsetFlag, "", "Unset a config field")
 	_ = viper.BindPFlag(UnsetFlag, cmd.Flags().Lookup(UnsetFlag))
+	cmd.Flags().Bool(ValidateFlag, false, "Check the config file for invalid values and unknown settings")
+	_ = viper.BindPFlag(ValidateFlag, cmd.Flags().Lookup(ValidateFlag))
 
 	return &configCmd
 }
@@ -103,15 +117,21 @@ func run() func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
 		switch {
 		case viper.GetBool(ListFlag):
-			config, _, err := getConfig()
+			conf, _, err := getConfig()
 			if err != nil {
 				return err
 			}
 
-			configJSON, err := json.Marshal(config)
+			configJSON, err := json.Marshal(conf)
 			if err != nil {
 				return err
 			}
+			if len(conf.Profiles) > 0 {
+				configJSON, err = withActiveProfile(configJSON, conf)
+				if err != nil {
+					return err
+				}
+			}
 
 			output, err := output.CmdOutputSingular(
 				viper.GetString(cliflags.OutputFlag),
@@ -122,7 +142,7 @@ func run() func(*cobra.Command, []string) error {
 				return err
 			}
 
-			fmt.Fprintf(cmd.OutOrStdout(), output+"\n")
+			fmt.Fprint(cmd.OutOrStdout(), output+"\n")
 		case viper.GetBool(SetFlag):
 			// flag needs two arguments: a key and value
 			if len(args)%2 != 0 {
@@ -141,13 +161,15 @@ func run() func(*cobra.Command, []string) error {
 				return err
 			}
 
+			profileConfig, _ := config.Profile(rawConfig, viper.GetString(cliflags.ProfileFlag), true)
+
 			// add arg pairs to config
 			// where each argument is --set arg1 val1 --set arg2 val2
 			for i, a := range args {
 				if i%2 == 0 {
-					rawConfig[a] = struct{}{}
+					profileConfig[a] = struct{}{}
 				} else {
-					rawConfig[args[i-1]] = a
+					profileConfig[args[i-1]] = a
 				}
 			}
 
@@ -155,11 +177,18 @@ func run() func(*cobra.Command, []string) error {
 				v.Set(key, value)
 			}
 
+			err = config.SaveAccessToken(profileConfig, viper.GetString(cliflags.ProfileFlag), v.ConfigFileUsed())
+			if err != nil {
+				return errors.NewError(err.Error())
+			}
+
 			configFile, err := config.NewConfig(rawConfig)
 			if err != nil {
 				return errors.NewError(err.Error())
 			}
 
+			warnUnknownSettings(cmd.ErrOrStderr(), configFile)
+
 			return writeConfig(configFile, v, setKeyFn)
 		case viper.IsSet(UnsetFlag):
 			_, ok := cliflags.AllFlagsHelp()[viper.GetString(UnsetFlag)]
@@ -167,23 +196,54 @@ func run() func(*cobra.Command, []string) error {
 				return newErr(viper.GetString(UnsetFlag))
 			}
 
-			config, v, err := getConfig()
+			rawConfig, v, err := getRawConfig()
 			if err != nil {
 				return err
 			}
 
-			unsetKeyFn := func(key string, value interface{}, v *viper.Viper) {
-				if key != viper.GetString("unset") {
-					v.Set(key, value)
+			profileConfig, ok := config.Profile(rawConfig, viper.GetString(cliflags.ProfileFlag), false)
+			if !ok {
+				return errors.NewError(fmt.Sprintf("profile %s not found", viper.GetString(cliflags.ProfileFlag)))
+			}
+			if viper.GetString(UnsetFlag) == cliflags.AccessTokenFlag {
+				err = config.DeleteAccessToken(profileConfig, viper.GetString(cliflags.ProfileFlag), v.ConfigFileUsed())
+				if err != nil {
+					return errors.NewError(err.Error())
 				}
 			}
+			delete(profileConfig, viper.GetString(UnsetFlag))
+
+			configFile, err := config.NewConfig(rawConfig)
+			if err != nil {
+				return errors.NewError(err.Error())
+			}
+
+			setKeyFn := func(key string, value interface{}, v *viper.Viper) {
+				v.Set(key, value)
+			}
 
 			// TODO: show successful output
 
-			err = writeConfig(config, v, unsetKeyFn)
+			warnUnknownSettings(cmd.ErrOrStderr(), configFile)
+
+			err = writeConfig(configFile, v, setKeyFn)
+			if err != nil {
+				return err
+			}
+		case viper.IsSet(GetFlag):
+			key := viper.GetString(GetFlag)
+			if !config.IsSetting(key) {
+				return newErr(key)
+			}
+
+			value, err := getValue(key)
 			if err != nil {
 				return err
 			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), value)
+		case viper.GetBool(ValidateFlag):
+			return validate(cmd.OutOrStdout())
 		default:
 			return cmd.Help()
 		}
@@ -192,6 +252,119 @@ func run() func(*cobra.Command, []string) error {
 	}
 }
 
+// getValue gets the value of a setting in the active profile. If the profile uses a credential
+// store, the access token comes from the store.
+func getValue(key string) (string, error) {
+	configFile := viper.ConfigFileUsed()
+	if configFile == "" {
+		configFile = config.GetConfigFile()
+	}
+	rawConfig := make(map[string]interface{})
+	data, err := os.ReadFile(configFile)
+	if err != nil && !os.IsNotExist(err) {
+		return "", err
+	}
+	err = yaml.Unmarshal(data, &rawConfig)
+	if err != nil {
+		return "", errInvalidConfigFile()
+	}
+
+	profile := viper.GetString(cliflags.ProfileFlag)
+	profileConfig, ok := config.Profile(rawConfig, profile, false)
+	if !ok {
+		return "", errors.NewError(fmt.Sprintf("profile %s not found", profile))
+	}
+
+	if key == cliflags.AccessTokenFlag && profileConfig[key] == nil {
+		conf, err := config.NewConfig(profileConfig)
+		if err != nil {
+			return "", errors.NewError(err.Error())
+		}
+		if store, ok := config.NewCredentialStore(conf, configFile); ok {
+			if profile == "" {
+				profile = config.DefaultProfile
+			}
+			token, err := store.Get(profile)
+			if err != nil && !goerrors.Is(err, config.ErrCredentialNotFound) {
+				return "", errors.NewError(err.Error())
+			}
+			if token != "" {
+				return token, nil
+			}
+		}
+	}
+
+	value, ok := profileConfig[key]
+	if !ok || value == nil {
+		return "", errors.NewError(fmt.Sprintf("%s is not set", key))
+	}
+
+	return fmt.Sprintf("%v", value), nil
+}
+
+// validate checks the config file and writes the problems it finds. Unknown settings are only
+// warnings since the CLI ignores them.
+func validate(out io.Writer) error {
+	configFile := viper.ConfigFileUsed()
+	if configFile == "" {
+		configFile = config.GetConfigFile()
+	}
+	data, err := os.ReadFile(configFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return errors.NewError(fmt.Sprintf("config file %s does not exist", configFile))
+		}
+
+		return err
+	}
+
+	errs, warnings := config.Validate(data)
+	for _, w := range warnings {
+		fmt.Fprintf(out, "warning: %s\n", w)
+	}
+	if len(errs) > 0 {
+		var sb strings.Builder
+		sb.WriteString(fmt.Sprintf("config file %s is invalid:", configFile))
+		for _, e := range errs {
+			sb.WriteString("\n  " + e.String())
+		}
+
+		return errors.NewError(sb.String())
+	}
+
+	fmt.Fprintf(out, "config file %s is valid\n", configFile)
+
+	return nil
+}
+
+// warnUnknownSettings shows the settings in the config file the CLI doesn't support, such as a
+// misspelled setting, since they're easy to miss when they're ignored.
+func warnUnknownSettings(out io.Writer, conf config.ConfigFile) {
+	for _, key := range conf.UnknownSettings() {
+		fmt.Fprintf(out, "warning: %s is not a supported setting and is ignored\n", key)
+	}
+}
+
+// withActiveProfile adds the name of the profile the CLI is using to the config file data.
+func withActiveProfile(configJSON []byte, conf config.ConfigFile) ([]byte, error) {
+	activeProfile := viper.GetString(cliflags.ProfileFlag)
+	if activeProfile == "" {
+		activeProfile = config.DefaultProfile
+	}
+	if _, ok := conf.Profiles[activeProfile]; !ok && activeProfile != config.DefaultProfile {
+		return configJSON, nil
+	}
+
+	var rawConfig map[string]interface{}
+	err := json.Unmarshal(configJSON, &rawConfig)
+	if err != nil {
+		return nil, err
+	}
+	rawConfig["active-profile"] = activeProfile
+
+	return json.Marshal(rawConfig)
+}
+
 // getConfig builds a struct type of the values in the config file.
 func getConfig() (config.ConfigFile, *viper.Viper, error) {
 	v, err := getViperWithConfigFile()
@@ -207,7 +380,7 @@ func getConfig() (config.ConfigFile, *viper.Viper, error) {
 	var c config.ConfigFile
 	err = yaml.Unmarshal([]byte(data), &c)
 	if err != nil {
-		return config.ConfigFile{}, nil, err
+		return config.ConfigFile{}, nil, errInvalidConfigFile()
 	}
 
 	return c, v, nil
@@ -228,12 +401,18 @@ func getRawConfig() (map[string]interface{}, *viper.Viper, error) {
 	config := make(map[string]interface{}, 0)
 	err = yaml.Unmarshal([]byte(data), &config)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, errInvalidConfigFile()
 	}
 
 	return config, v, nil
 }
 
+// errInvalidConfigFile is the error for a config file that can't be read, such as one with a value
+// of the wrong type. The validate flag shows where the problems are.
+func errInvalidConfigFile() error {
+	return errors.NewError(fmt.Sprintf("config file is invalid. Use `ldcli config --%s` to find the problem", ValidateFlag))
+}
+
 // getViperWithConfigFile ensures the viper instance has a config file written to the filesystem.
 // We want to write the file when someone runs a config command.
 func getViperWithConfigFile() (*viper.Viper, error) {
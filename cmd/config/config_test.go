@@ -1,14 +1,19 @@
 package config_test
 
 import (
+	"encoding/json"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 
 	"ldcli/cmd"
 	"ldcli/internal/analytics"
+	"ldcli/internal/config"
 )
 
 func TestNoFlag(t *testing.T) {
@@ -29,3 +34,277 @@ func TestNoFlag(t *testing.T) {
 
 	assert.Equal(t, string(expected), string(output))
 }
+
+func TestProfiles(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.yml")
+	require.NoError(t, os.WriteFile(configFile, []byte("access-token: prod-token\n"), 0600))
+	viper.SetConfigType("yml")
+	viper.SetConfigFile(configFile)
+	defer viper.Reset()
+
+	t.Run("sets a value in a profile", func(t *testing.T) {
+		args := []string{
+			"config",
+			"--profile", "fed",
+			"--set", "base-uri", "https://app.launchdarkly.us",
+		}
+
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		data, err := os.ReadFile(configFile)
+		require.NoError(t, err)
+		var rawConfig map[string]interface{}
+		require.NoError(t, yaml.Unmarshal(data, &rawConfig))
+		assert.Equal(t, "prod-token", rawConfig["access-token"])
+		assert.Equal(
+			t,
+			"https://app.launchdarkly.us",
+			rawConfig["profiles"].(map[string]interface{})["fed"].(map[string]interface{})["base-uri"],
+		)
+	})
+
+	t.Run("lists every profile and the active one", func(t *testing.T) {
+		args := []string{
+			"config",
+			"--profile", "fed",
+			"--list",
+			"--output", "json",
+		}
+
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		var result map[string]interface{}
+		require.NoError(t, json.Unmarshal(output, &result))
+		assert.Equal(t, "fed", result["active-profile"])
+		assert.Equal(t, "prod-token", result["access-token"])
+		assert.Contains(t, result["profiles"], "fed")
+	})
+
+	t.Run("unsets a value in a profile", func(t *testing.T) {
+		args := []string{
+			"config",
+			"--profile", "fed",
+			"--unset", "base-uri",
+		}
+
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		data, err := os.ReadFile(configFile)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "base-uri")
+		assert.Contains(t, string(data), "prod-token")
+	})
+}
+
+func TestCredentialStore(t *testing.T) {
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, "config.yml")
+	require.NoError(t, os.WriteFile(configFile, []byte("access-token: prod-token\n"), 0600))
+	viper.SetConfigType("yml")
+	viper.SetConfigFile(configFile)
+	defer viper.Reset()
+	t.Setenv(config.KeyringPasswordEnvVar, "test-password")
+
+	t.Run("moves the access token to the encrypted file", func(t *testing.T) {
+		args := []string{
+			"config",
+			"--set", "credential-store", "file",
+		}
+
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		data, err := os.ReadFile(configFile)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "prod-token")
+		assert.Contains(t, string(data), "credential-store: file")
+		token, err := config.NewFileStore(filepath.Join(dir, config.CredentialsFilename), "test-password").Get("default")
+		require.NoError(t, err)
+		assert.Equal(t, "prod-token", token)
+	})
+
+	t.Run("sets the access token in the encrypted file", func(t *testing.T) {
+		args := []string{
+			"config",
+			"--set", "access-token", "new-token",
+		}
+
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		data, err := os.ReadFile(configFile)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "new-token")
+		token, err := config.NewFileStore(filepath.Join(dir, config.CredentialsFilename), "test-password").Get("default")
+		require.NoError(t, err)
+		assert.Equal(t, "new-token", token)
+	})
+
+	t.Run("unsets the access token in the encrypted file", func(t *testing.T) {
+		args := []string{
+			"config",
+			"--unset", "access-token",
+		}
+
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		_, err = config.NewFileStore(filepath.Join(dir, config.CredentialsFilename), "test-password").Get("default")
+		assert.ErrorIs(t, err, config.ErrCredentialNotFound)
+	})
+}
+
+func TestGet(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.yml")
+	require.NoError(t, os.WriteFile(configFile, []byte(`project: default
+profiles:
+  fed:
+    project: fed-project
+`), 0600))
+	viper.SetConfigType("yml")
+	viper.SetConfigFile(configFile)
+	defer viper.Reset()
+
+	t.Run("gets a value", func(t *testing.T) {
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{},
+			analytics.NoopClientFn{}.Tracker(),
+			[]string{"config", "--get", "project"},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, "default\n", string(output))
+	})
+
+	t.Run("gets a value in a profile", func(t *testing.T) {
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{},
+			analytics.NoopClientFn{}.Tracker(),
+			[]string{"config", "--profile", "fed", "--get", "project"},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, "fed-project\n", string(output))
+	})
+
+	t.Run("is an error when the value is not set", func(t *testing.T) {
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{},
+			analytics.NoopClientFn{}.Tracker(),
+			[]string{"config", "--get", "environment"},
+		)
+
+		assert.EqualError(t, err, "environment is not set")
+	})
+}
+
+func TestValidate(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.yml")
+	viper.SetConfigType("yml")
+	viper.SetConfigFile(configFile)
+	defer viper.Reset()
+
+	t.Run("with a valid config file", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(configFile, []byte("project: default\nprojcet: other\n"), 0600))
+
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{},
+			analytics.NoopClientFn{}.Tracker(),
+			[]string{"config", "--validate"},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(
+			t,
+			"warning: line 2: projcet is not a supported setting and is ignored\nconfig file "+configFile+" is valid\n",
+			string(output),
+		)
+	})
+
+	t.Run("with an invalid config file", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(configFile, []byte("project: default\nmax-retries: many\ntimeout: soon\n"), 0600))
+
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{},
+			analytics.NoopClientFn{}.Tracker(),
+			[]string{"config", "--validate"},
+		)
+
+		assert.EqualError(
+			t,
+			err,
+			"config file "+configFile+" is invalid:\n"+
+				"  line 2: max-retries must be a number greater than or equal to 0\n"+
+				"  line 3: timeout must be a duration such as 30s or 2m",
+		)
+	})
+
+	t.Run("lists with an invalid config file", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(configFile, []byte("project: default\nmax-retries: many\n"), 0600))
+
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{},
+			analytics.NoopClientFn{}.Tracker(),
+			[]string{"config", "--list"},
+		)
+
+		assert.EqualError(t, err, "config file is invalid. Use `ldcli config --validate` to find the problem")
+	})
+
+	t.Run("keeps unknown settings when setting a value", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(configFile, []byte("projcet: other\n"), 0600))
+		require.NoError(t, viper.ReadInConfig())
+
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{},
+			analytics.NoopClientFn{}.Tracker(),
+			[]string{"config", "--set", "project", "default"},
+		)
+
+		require.NoError(t, err)
+		data, err := os.ReadFile(configFile)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), "projcet: other")
+		assert.Contains(t, string(data), "project: default")
+	})
+}
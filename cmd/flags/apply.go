@@ -0,0 +1,180 @@
+package flags
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"ldcli/cmd/cliflags"
+	resourcescmd "ldcli/cmd/resources"
+	"ldcli/cmd/validators"
+	"ldcli/internal/errors"
+	"ldcli/internal/flags"
+	"ldcli/internal/output"
+	"ldcli/internal/resources"
+)
+
+const (
+	applyResultCreated   = "created"
+	applyResultUnchanged = "unchanged"
+	applyResultUpdated   = "updated"
+)
+
+func NewApplyCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Args: validators.Validate(),
+		Long: `Change flags to match the flag files in a directory.
+
+Each flag's settings are compared with its file, and only the settings that are different are changed. Settings that aren't in a file aren't changed. Flags that don't exist are created. Use --dry-run to see the changes without making them.`,
+		RunE:  runApply(client),
+		Short: "Apply flag files to flags",
+		Use:   "apply",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(cliflags.DirFlag, "", "The directory with the flag files")
+	_ = cmd.MarkFlagRequired(cliflags.DirFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.DirFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.DirFlag, cmd.Flags().Lookup(cliflags.DirFlag))
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	return cmd
+}
+
+type applyResult struct {
+	Key    string              `json:"key"`
+	Result string              `json:"result"`
+	Patch  []flags.UpdateInput `json:"patch"`
+}
+
+func runApply(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		dir := viper.GetString(cliflags.DirFlag)
+		files, err := flags.ReadFlagFiles(dir)
+		if err != nil {
+			return errors.NewError(err.Error())
+		}
+		if len(files) == 0 {
+			return errors.NewError(fmt.Sprintf("%s doesn't have any flag files", dir))
+		}
+
+		accessToken := viper.GetString(cliflags.AccessTokenFlag)
+		baseURI := viper.GetString(cliflags.BaseURIFlag)
+		projKey := viper.GetString(cliflags.ProjectFlag)
+		// the current flags are read even when only printing the changes so the changes can be found
+		readClient := resourcescmd.WithRequestOptions(client)
+		requestClient, printOnly := resourcescmd.NewRequestClient(cmd, client)
+
+		results := make([]applyResult, 0, len(files))
+		for _, file := range files {
+			result := applyResult{Key: file.Key, Result: applyResultUpdated}
+			flag, err := flags.GetFlag(readClient, accessToken, baseURI, projKey, file.Key)
+			if errors.IsNotFound(err) {
+				flag, err = createFlag(requestClient, accessToken, baseURI, projKey, file)
+				if err != nil {
+					return errors.NewErrorWrapped(output.CmdOutputError(viper.GetString(cliflags.OutputFlag), err), err)
+				}
+				if printOnly {
+					// the targeting can't be shown until the flag exists
+					continue
+				}
+				result.Result = applyResultCreated
+			}
+			if err != nil {
+				return errors.NewErrorWrapped(output.CmdOutputError(viper.GetString(cliflags.OutputFlag), err), err)
+			}
+
+			patch, err := flags.BuildPatch(flag, file)
+			if err != nil {
+				return errors.NewError(fmt.Sprintf("%s: %s", file.Key, err))
+			}
+			result.Patch = patch
+			if len(patch) == 0 {
+				if result.Result != applyResultCreated {
+					result.Result = applyResultUnchanged
+				}
+				results = append(results, result)
+				continue
+			}
+
+			data, err := json.Marshal(patch)
+			if err != nil {
+				return errors.NewError(err.Error())
+			}
+			_, err = requestClient.MakeRequest(
+				accessToken,
+				"PATCH",
+				fmt.Sprintf("%s/api/v2/flags/%s/%s", baseURI, projKey, file.Key),
+				"application/json",
+				nil,
+				data,
+			)
+			if err != nil {
+				return errors.NewErrorWrapped(output.CmdOutputError(viper.GetString(cliflags.OutputFlag), err), err)
+			}
+			results = append(results, result)
+		}
+		if printOnly {
+			return nil
+		}
+
+		res, err := json.Marshal(map[string]interface{}{"flags": results})
+		if err != nil {
+			return errors.NewError(err.Error())
+		}
+		output, err := output.CmdOutputSingular(
+			viper.GetString(cliflags.OutputFlag),
+			res,
+			output.FlagsApplyPlaintextOutputFn,
+		)
+		if err != nil {
+			return errors.NewError(err.Error())
+		}
+
+		fmt.Fprint(cmd.OutOrStdout(), output+"\n")
+
+		return nil
+	}
+}
+
+// createFlag creates the flag with the settings from its file and gets the new flag so its
+// targeting can be changed to match the file.
+func createFlag(
+	client resources.Client,
+	accessToken, baseURI, projKey string,
+	file flags.FlagFile,
+) (map[string]interface{}, error) {
+	data, err := json.Marshal(flags.NewFlagBody(file))
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.MakeRequest(
+		accessToken,
+		"POST",
+		fmt.Sprintf("%s/api/v2/flags/%s", baseURI, projKey),
+		"application/json",
+		nil,
+		data,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, nil
+	}
+
+	var flag map[string]interface{}
+	err = json.Unmarshal(res, &flag)
+	if err != nil {
+		return nil, err
+	}
+
+	return flag, nil
+}
@@ -0,0 +1,211 @@
+package flags_test
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ldcli/cmd"
+	"ldcli/internal/analytics"
+	"ldcli/internal/errors"
+	"ldcli/internal/resources"
+)
+
+// fakeClient responds to each request with the response or error for its method and URL. It
+// responds to other requests with an empty JSON object.
+type fakeClient struct {
+	errors    map[string]error
+	responses map[string][]byte
+	requests  []string
+	inputs    [][]byte
+}
+
+func (c *fakeClient) MakeRequest(
+	accessToken, method, path, contentType string,
+	query url.Values,
+	data []byte,
+) ([]byte, error) {
+	request := method + " " + path
+	c.requests = append(c.requests, request)
+	c.inputs = append(c.inputs, data)
+	if err, ok := c.errors[request]; ok {
+		return nil, err
+	}
+	if res, ok := c.responses[request]; ok {
+		return res, nil
+	}
+
+	return []byte(`{}`), nil
+}
+
+func TestApply(t *testing.T) {
+	const flag = `{
+		"key": "test-flag",
+		"name": "test flag",
+		"variations": [{"_id": "a", "value": true}, {"_id": "b", "value": false}],
+		"environments": {"production": {"on": true, "offVariation": 1}}
+	}`
+
+	t.Run("changes the settings that are different", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(
+			filepath.Join(dir, "test-flag.yml"),
+			[]byte("key: test-flag\nname: test flag\nenvironments:\n  production:\n    on: false\n    offVariation: 1\n"),
+			0644,
+		))
+		mockClient := &resources.MockClient{
+			Response: []byte(flag),
+		}
+		args := []string{
+			"flags", "apply",
+			"--access-token", "abcd1234",
+			"--project", "test-proj",
+			"--dir", dir,
+		}
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: mockClient,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `[{"op": "replace", "path": "/environments/production/on", "value": false}]`, string(mockClient.Input))
+		assert.Equal(t, "Updated test-flag\n  replace /environments/production/on\n", string(output))
+	})
+
+	t.Run("without changes", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "test-flag.json"), []byte(`{"key": "test-flag", "name": "test flag"}`), 0644))
+		mockClient := &resources.MockClient{
+			Response: []byte(flag),
+		}
+		args := []string{
+			"flags", "apply",
+			"--access-token", "abcd1234",
+			"--project", "test-proj",
+			"--dir", dir,
+		}
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: mockClient,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.Nil(t, mockClient.Input)
+		assert.Equal(t, "test-flag is up to date\n", string(output))
+	})
+
+	t.Run("creates a flag that doesn't exist", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(
+			filepath.Join(dir, "test-flag.yml"),
+			[]byte("key: test-flag\nname: test flag\nenvironments:\n  production:\n    on: false\n"),
+			0644,
+		))
+		client := &fakeClient{
+			errors: map[string]error{
+				"GET http://test.com/api/v2/flags/test-proj/test-flag": errors.NewResponseError(404, "", []byte(`{"code":"not_found","message":"Unknown resource"}`)),
+			},
+			responses: map[string][]byte{
+				"POST http://test.com/api/v2/flags/test-proj": []byte(flag),
+			},
+		}
+		args := []string{
+			"flags", "apply",
+			"--access-token", "abcd1234",
+			"--base-uri", "http://test.com",
+			"--project", "test-proj",
+			"--dir", dir,
+		}
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: client,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"GET http://test.com/api/v2/flags/test-proj/test-flag",
+			"POST http://test.com/api/v2/flags/test-proj",
+			"PATCH http://test.com/api/v2/flags/test-proj/test-flag",
+		}, client.requests)
+		assert.JSONEq(t, `{"key": "test-flag", "name": "test flag"}`, string(client.inputs[1]))
+		assert.Equal(t, "Created test-flag\n  replace /environments/production/on\n", string(output))
+	})
+
+	t.Run("with --dry-run", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "test-flag.json"), []byte(`{"key": "test-flag", "tags": ["beta"]}`), 0644))
+		mockClient := &resources.MockClient{
+			Response: []byte(flag),
+		}
+		args := []string{
+			"flags", "apply",
+			"--access-token", "abcd1234",
+			"--base-uri", "http://test.com",
+			"--project", "test-proj",
+			"--dir", dir,
+			"--dry-run",
+		}
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: mockClient,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.Nil(t, mockClient.Input)
+		assert.Equal(t, `PATCH http://test.com/api/v2/flags/test-proj/test-flag
+Authorization: [REDACTED]
+Content-Type: application/json
+User-Agent: launchdarkly-cli/vtest
+
+[
+  {
+    "op": "add",
+    "path": "/tags",
+    "value": [
+      "beta"
+    ]
+  }
+]
+`, string(output))
+	})
+
+	t.Run("without flag files", func(t *testing.T) {
+		dir := t.TempDir()
+		args := []string{
+			"flags", "apply",
+			"--access-token", "abcd1234",
+			"--project", "test-proj",
+			"--dir", dir,
+		}
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: &resources.MockClient{},
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		assert.EqualError(t, err, dir+" doesn't have any flag files")
+	})
+}
@@ -0,0 +1,78 @@
+package flags
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"ldcli/cmd/cliflags"
+	resourcescmd "ldcli/cmd/resources"
+	"ldcli/cmd/validators"
+	"ldcli/internal/browse"
+	"ldcli/internal/debug"
+	"ldcli/internal/errors"
+	"ldcli/internal/flags"
+	"ldcli/internal/resources"
+)
+
+func NewBrowseCmd(resourcesClient resources.Client, client flags.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Args: validators.Validate(),
+		Long: `Browse the feature flags in a project.
+
+Filter the list by pressing /. Press enter to choose an environment for the selected flag, then press tab to toggle the flag in that environment after confirming it.`,
+		RunE:  runBrowse(resourcesClient, client),
+		Short: "Browse and toggle feature flags",
+		Use:   "browse",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	return cmd
+}
+
+func runBrowse(resourcesClient resources.Client, client flags.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		// the browser toggles flags right away, so it can't only print the requests
+		if viper.GetBool(cliflags.DryRunFlag) || viper.GetBool(cliflags.PrintCurlFlag) {
+			return errors.NewError(fmt.Sprintf(
+				"`ldcli flags browse` doesn't support --%s or --%s",
+				cliflags.DryRunFlag,
+				cliflags.PrintCurlFlag,
+			))
+		}
+
+		// the browser takes over the terminal, so debug logs go to a file instead of stderr
+		if debug.Enabled() {
+			f, err := tea.LogToFile("debug.log", "")
+			if err != nil {
+				return errors.NewError(fmt.Sprintf("could not open file for debugging: %s", err))
+			}
+			defer f.Close()
+			debug.SetOutput(f)
+		}
+
+		_, err := tea.NewProgram(
+			browse.NewModel(
+				resourcescmd.WithRequestOptions(resourcesClient),
+				client,
+				viper.GetString(cliflags.AccessTokenFlag),
+				viper.GetString(cliflags.BaseURIFlag),
+				viper.GetString(cliflags.ProjectFlag),
+			),
+			tea.WithAltScreen(),
+		).Run()
+		if err != nil {
+			return errors.NewError(err.Error())
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,49 @@
+package flags_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"ldcli/cmd"
+	"ldcli/internal/analytics"
+	"ldcli/internal/flags"
+)
+
+func TestBrowse(t *testing.T) {
+	t.Run("with --dry-run is an error", func(t *testing.T) {
+		args := []string{
+			"flags", "browse",
+			"--access-token", "abcd1234",
+			"--project", "test-proj",
+			"--dry-run",
+		}
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				FlagsClient: &flags.MockClient{},
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		assert.EqualError(t, err, "`ldcli flags browse` doesn't support --dry-run or --print-curl")
+	})
+
+	t.Run("without a project is an error", func(t *testing.T) {
+		args := []string{
+			"flags", "browse",
+			"--access-token", "abcd1234",
+		}
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				FlagsClient: &flags.MockClient{},
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		assert.ErrorContains(t, err, `required flag(s) "project" not set`)
+	})
+}
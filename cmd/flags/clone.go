@@ -0,0 +1,164 @@
+package flags
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"ldcli/cmd/cliflags"
+	resourcescmd "ldcli/cmd/resources"
+	"ldcli/cmd/validators"
+	"ldcli/internal/errors"
+	"ldcli/internal/flags"
+	"ldcli/internal/output"
+	"ldcli/internal/resources"
+)
+
+func NewCloneCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Args: validators.Validate(),
+		Long: `Copy a flag to another project.
+
+The new flag has the same name, description, variations, tags, and client-side availability. For each environment with the same key in both projects, it also has the same targeting. Flags with prerequisites or segment rules can't be cloned because those refer to flags and segments in the source project.`,
+		RunE:  runClone(client),
+		Short: "Copy a flag to another project",
+		Use:   "clone",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(cliflags.FlagFlag, "", "The feature flag key")
+	_ = cmd.MarkFlagRequired(cliflags.FlagFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.FlagFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.FlagFlag, cmd.Flags().Lookup(cliflags.FlagFlag))
+
+	cmd.Flags().String(cliflags.FromProjectFlag, "", "The project key to copy the flag from")
+	_ = cmd.MarkFlagRequired(cliflags.FromProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.FromProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.FromProjectFlag, cmd.Flags().Lookup(cliflags.FromProjectFlag))
+
+	cmd.Flags().String(cliflags.NewKeyFlag, "", "The key for the new flag. Defaults to the flag's key")
+	_ = viper.BindPFlag(cliflags.NewKeyFlag, cmd.Flags().Lookup(cliflags.NewKeyFlag))
+
+	cmd.Flags().String(cliflags.ToProjectFlag, "", "The project key to copy the flag to")
+	_ = cmd.MarkFlagRequired(cliflags.ToProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ToProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ToProjectFlag, cmd.Flags().Lookup(cliflags.ToProjectFlag))
+
+	return cmd
+}
+
+func runClone(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		accessToken := viper.GetString(cliflags.AccessTokenFlag)
+		baseURI := viper.GetString(cliflags.BaseURIFlag)
+		flagKey := viper.GetString(cliflags.FlagFlag)
+		fromProjKey := viper.GetString(cliflags.FromProjectFlag)
+		toProjKey := viper.GetString(cliflags.ToProjectFlag)
+		newKey := viper.GetString(cliflags.NewKeyFlag)
+		if newKey == "" {
+			newKey = flagKey
+		}
+
+		// the flag is read even when only printing the requests to create the copy
+		source, err := flags.GetFlag(resourcescmd.WithRequestOptions(client), accessToken, baseURI, fromProjKey, flagKey)
+		if err != nil {
+			return errors.NewErrorWrapped(output.CmdOutputError(viper.GetString(cliflags.OutputFlag), err), err)
+		}
+		file := flags.NewFlagFile(source)
+		file.Key = newKey
+
+		// prerequisites and segments can't be copied since they're only in the source project
+		references := flags.ProjectReferences(file)
+		if len(references) > 0 {
+			return errors.NewError(fmt.Sprintf(
+				"%s can't be cloned because its targeting uses flags and segments from %s: %s",
+				flagKey,
+				fromProjKey,
+				strings.Join(references, ", "),
+			))
+		}
+
+		requestClient, printOnly := resourcescmd.NewRequestClient(cmd, client)
+		created, err := createFlag(requestClient, accessToken, baseURI, toProjKey, file)
+		if err != nil {
+			return errors.NewErrorWrapped(output.CmdOutputError(viper.GetString(cliflags.OutputFlag), err), err)
+		}
+		if printOnly {
+			// the targeting can't be shown until the flag exists
+			return nil
+		}
+
+		// only copy the targeting for environments in both projects
+		createdEnvironments, _ := created["environments"].(map[string]interface{})
+		copied := make([]string, 0, len(file.Environments))
+		skipped := make([]string, 0)
+		for envKey := range file.Environments {
+			if _, ok := createdEnvironments[envKey]; ok {
+				copied = append(copied, envKey)
+			} else {
+				skipped = append(skipped, envKey)
+				delete(file.Environments, envKey)
+			}
+		}
+		sort.Strings(copied)
+		sort.Strings(skipped)
+
+		patch, err := flags.BuildPatch(created, file)
+		if err != nil {
+			return errors.NewError(err.Error())
+		}
+		if len(patch) > 0 {
+			data, err := json.Marshal(patch)
+			if err != nil {
+				return errors.NewError(err.Error())
+			}
+			path := fmt.Sprintf("%s/api/v2/flags/%s/%s", baseURI, toProjKey, newKey)
+			_, err = requestClient.MakeRequest(accessToken, "PATCH", path, "application/json", nil, data)
+			if err != nil {
+				// remove the new flag so it isn't left without its targeting
+				_, deleteErr := requestClient.MakeRequest(accessToken, "DELETE", path, "application/json", nil, nil)
+				if deleteErr != nil {
+					message := fmt.Sprintf(
+						"created %s in %s without its targeting because the targeting couldn't be copied: %s",
+						newKey,
+						toProjKey,
+						output.NewErrorEnvelope(err).Message,
+					)
+
+					return errors.NewErrorWrapped(output.CmdOutputError(viper.GetString(cliflags.OutputFlag), errors.NewError(message)), err)
+				}
+
+				return errors.NewErrorWrapped(output.CmdOutputError(viper.GetString(cliflags.OutputFlag), err), err)
+			}
+		}
+
+		res, err := json.Marshal(map[string]interface{}{
+			"key":                 flagKey,
+			"newKey":              newKey,
+			"fromProject":         fromProjKey,
+			"toProject":           toProjKey,
+			"environments":        copied,
+			"skippedEnvironments": skipped,
+		})
+		if err != nil {
+			return errors.NewError(err.Error())
+		}
+		output, err := output.CmdOutputSingular(
+			viper.GetString(cliflags.OutputFlag),
+			res,
+			output.FlagsClonePlaintextOutputFn,
+		)
+		if err != nil {
+			return errors.NewError(err.Error())
+		}
+
+		fmt.Fprint(cmd.OutOrStdout(), output+"\n")
+
+		return nil
+	}
+}
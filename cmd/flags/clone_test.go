@@ -0,0 +1,222 @@
+package flags_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ldcli/cmd"
+	"ldcli/internal/analytics"
+	"ldcli/internal/errors"
+	"ldcli/internal/resources"
+)
+
+func TestClone(t *testing.T) {
+	const source = `{
+		"key": "test-flag",
+		"name": "test flag",
+		"tags": ["beta"],
+		"clientSideAvailability": {"usingEnvironmentId": true, "usingMobileKey": false},
+		"variations": [{"_id": "a", "value": "blue"}, {"_id": "b", "value": "green"}],
+		"environments": {
+			"production": {"on": true, "offVariation": 1, "fallthrough": {"variation": 0}},
+			"qa": {"on": true, "offVariation": 1, "fallthrough": {"variation": 1}}
+		}
+	}`
+	const created = `{
+		"key": "new-flag",
+		"name": "test flag",
+		"tags": ["beta"],
+		"clientSideAvailability": {"usingEnvironmentId": true, "usingMobileKey": false},
+		"variations": [{"_id": "c", "value": "blue"}, {"_id": "d", "value": "green"}],
+		"environments": {
+			"production": {"on": false, "offVariation": 1, "fallthrough": {"variation": 0}}
+		}
+	}`
+
+	t.Run("copies the flag and the targeting in matching environments", func(t *testing.T) {
+		client := &fakeClient{
+			responses: map[string][]byte{
+				"GET http://test.com/api/v2/flags/old-proj/test-flag": []byte(source),
+				"POST http://test.com/api/v2/flags/new-proj":          []byte(created),
+			},
+		}
+		args := []string{
+			"flags", "clone",
+			"--access-token", "abcd1234",
+			"--base-uri", "http://test.com",
+			"--flag", "test-flag",
+			"--from-project", "old-proj",
+			"--to-project", "new-proj",
+			"--new-key", "new-flag",
+		}
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: client,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"GET http://test.com/api/v2/flags/old-proj/test-flag",
+			"POST http://test.com/api/v2/flags/new-proj",
+			"PATCH http://test.com/api/v2/flags/new-proj/new-flag",
+		}, client.requests)
+		assert.JSONEq(t, `{
+			"key": "new-flag",
+			"name": "test flag",
+			"tags": ["beta"],
+			"clientSideAvailability": {"usingEnvironmentId": true, "usingMobileKey": false},
+			"variations": [{"value": "blue"}, {"value": "green"}]
+		}`, string(client.inputs[1]))
+		assert.JSONEq(t, `[{"op": "replace", "path": "/environments/production/on", "value": true}]`, string(client.inputs[2]))
+		assert.Equal(t, "Cloned test-flag from old-proj to new-proj as new-flag\n"+
+			"Copied the targeting in production\n"+
+			"Didn't copy the targeting in qa because new-proj doesn't have an environment with the same key\n", string(output))
+	})
+
+	t.Run("with prerequisites and segments is an error", func(t *testing.T) {
+		client := &fakeClient{
+			responses: map[string][]byte{
+				"GET http://test.com/api/v2/flags/old-proj/test-flag": []byte(`{
+					"key": "test-flag",
+					"environments": {
+						"production": {
+							"prerequisites": [{"key": "other-flag", "variation": 0}],
+							"rules": [{"clauses": [{"attribute": "segmentMatch", "op": "segmentMatch", "values": ["beta-users"]}]}]
+						}
+					}
+				}`),
+			},
+		}
+		args := []string{
+			"flags", "clone",
+			"--access-token", "abcd1234",
+			"--base-uri", "http://test.com",
+			"--flag", "test-flag",
+			"--from-project", "old-proj",
+			"--to-project", "new-proj",
+		}
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: client,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		assert.EqualError(t, err, "test-flag can't be cloned because its targeting uses flags and segments from old-proj: "+
+			"prerequisite flag other-flag in production, segment beta-users in production")
+		assert.Equal(t, []string{"GET http://test.com/api/v2/flags/old-proj/test-flag"}, client.requests)
+	})
+
+	t.Run("deletes the new flag when the targeting can't be copied", func(t *testing.T) {
+		client := &fakeClient{
+			responses: map[string][]byte{
+				"GET http://test.com/api/v2/flags/old-proj/test-flag": []byte(source),
+				"POST http://test.com/api/v2/flags/new-proj":          []byte(created),
+			},
+			errors: map[string]error{
+				"PATCH http://test.com/api/v2/flags/new-proj/new-flag": errors.NewResponseError(
+					400,
+					"",
+					[]byte(`{"code": "invalid_request", "message": "invalid patch"}`),
+				),
+			},
+		}
+		args := []string{
+			"flags", "clone",
+			"--access-token", "abcd1234",
+			"--base-uri", "http://test.com",
+			"--flag", "test-flag",
+			"--from-project", "old-proj",
+			"--to-project", "new-proj",
+			"--new-key", "new-flag",
+		}
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: client,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		assert.EqualError(t, err, "invalid patch (code: invalid_request)")
+		assert.Equal(t, []string{
+			"GET http://test.com/api/v2/flags/old-proj/test-flag",
+			"POST http://test.com/api/v2/flags/new-proj",
+			"PATCH http://test.com/api/v2/flags/new-proj/new-flag",
+			"DELETE http://test.com/api/v2/flags/new-proj/new-flag",
+		}, client.requests)
+	})
+
+	t.Run("reports the new flag without its targeting when it can't be deleted", func(t *testing.T) {
+		client := &fakeClient{
+			responses: map[string][]byte{
+				"GET http://test.com/api/v2/flags/old-proj/test-flag": []byte(source),
+				"POST http://test.com/api/v2/flags/new-proj":          []byte(created),
+			},
+			errors: map[string]error{
+				"PATCH http://test.com/api/v2/flags/new-proj/new-flag": errors.NewResponseError(
+					409,
+					"",
+					[]byte(`{"code": "conflict", "message": "the flag was changed"}`),
+				),
+				"DELETE http://test.com/api/v2/flags/new-proj/new-flag": errors.NewResponseError(500, "", nil),
+			},
+		}
+		args := []string{
+			"flags", "clone",
+			"--access-token", "abcd1234",
+			"--base-uri", "http://test.com",
+			"--flag", "test-flag",
+			"--from-project", "old-proj",
+			"--to-project", "new-proj",
+			"--new-key", "new-flag",
+		}
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: client,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		assert.EqualError(t, err, "created new-flag in new-proj without its targeting because the targeting couldn't be copied: the flag was changed")
+		assert.Equal(t, errors.ExitCodeConflict, errors.ExitCode(err))
+	})
+
+	t.Run("with --dry-run", func(t *testing.T) {
+		mockClient := &resources.MockClient{
+			Response: []byte(source),
+		}
+		args := []string{
+			"flags", "clone",
+			"--access-token", "abcd1234",
+			"--base-uri", "http://test.com",
+			"--flag", "test-flag",
+			"--from-project", "old-proj",
+			"--to-project", "new-proj",
+			"--dry-run",
+		}
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: mockClient,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.Contains(t, string(output), "POST http://test.com/api/v2/flags/new-proj\n")
+		assert.Contains(t, string(output), `"key": "test-flag"`)
+	})
+}
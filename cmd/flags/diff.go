@@ -0,0 +1,134 @@
+package flags
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"ldcli/cmd/cliflags"
+	resourcescmd "ldcli/cmd/resources"
+	"ldcli/cmd/validators"
+	"ldcli/internal/errors"
+	"ldcli/internal/flags"
+	"ldcli/internal/output"
+	"ldcli/internal/resources"
+)
+
+func NewDiffCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Args: validators.Validate(),
+		Long: `Compare a flag's targeting in two environments.
+
+Shows the differences in whether the flag is on, its off variation, fallthrough, prerequisites, targets, context targets, and rules. Use --all-flags instead of --flag to see which flags in the project are different.`,
+		RunE:  runDiff(client),
+		Short: "Compare a flag's targeting in two environments",
+		Use:   "diff",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().Bool(cliflags.AllFlagsFlag, false, "Compare every flag in the project")
+	_ = viper.BindPFlag(cliflags.AllFlagsFlag, cmd.Flags().Lookup(cliflags.AllFlagsFlag))
+
+	cmd.Flags().String(cliflags.FlagFlag, "", "The feature flag key")
+	_ = viper.BindPFlag(cliflags.FlagFlag, cmd.Flags().Lookup(cliflags.FlagFlag))
+
+	cmd.Flags().String(cliflags.FromFlag, "", "The environment key to compare from")
+	_ = cmd.MarkFlagRequired(cliflags.FromFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.FromFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.FromFlag, cmd.Flags().Lookup(cliflags.FromFlag))
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	cmd.Flags().String(cliflags.ToFlag, "", "The environment key to compare to")
+	_ = cmd.MarkFlagRequired(cliflags.ToFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ToFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ToFlag, cmd.Flags().Lookup(cliflags.ToFlag))
+
+	return cmd
+}
+
+func runDiff(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		allFlags := viper.GetBool(cliflags.AllFlagsFlag)
+		flagKey := viper.GetString(cliflags.FlagFlag)
+		if allFlags == (flagKey != "") {
+			return errors.NewUsageError(errors.NewError(fmt.Sprintf(
+				"use either --%s or --%s",
+				cliflags.FlagFlag,
+				cliflags.AllFlagsFlag,
+			)))
+		}
+
+		var flagKeys []string
+		if !allFlags {
+			flagKeys = []string{flagKey}
+		}
+		fromKey := viper.GetString(cliflags.FromFlag)
+		toKey := viper.GetString(cliflags.ToFlag)
+		requestClient, printOnly := resourcescmd.NewRequestClient(cmd, client)
+		items, err := getFlagEnvironments(requestClient, flagKeys, fromKey, toKey)
+		if err != nil {
+			return errors.NewErrorWrapped(output.CmdOutputError(viper.GetString(cliflags.OutputFlag), err), err)
+		}
+		if printOnly {
+			return nil
+		}
+
+		var res []byte
+		var plaintextFn output.PlaintextOutputFn
+		if allFlags {
+			res, err = diffAllFlags(items, fromKey, toKey)
+			plaintextFn = output.FlagsDiffPlaintextOutputFn
+		} else {
+			res, err = diffFlag(items[0], fromKey, toKey)
+			plaintextFn = output.FlagDiffPlaintextOutputFn
+		}
+		if err != nil {
+			return errors.NewError(err.Error())
+		}
+
+		output, err := output.CmdOutputSingular(viper.GetString(cliflags.OutputFlag), res, plaintextFn)
+		if err != nil {
+			return errors.NewError(err.Error())
+		}
+
+		fmt.Fprint(cmd.OutOrStdout(), output+"\n")
+
+		return nil
+	}
+}
+
+func diffFlag(item flagEnvironments, fromKey, toKey string) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"key":         item.key,
+		"from":        fromKey,
+		"to":          toKey,
+		"differences": flags.DiffEnvironments(item.from, item.to),
+	})
+}
+
+func diffAllFlags(items []flagEnvironments, fromKey, toKey string) ([]byte, error) {
+	different := make([]map[string]interface{}, 0)
+	for _, item := range items {
+		differences := flags.DiffEnvironments(item.from, item.to)
+		if len(differences) > 0 {
+			different = append(different, map[string]interface{}{
+				"key":         item.key,
+				"differences": differences,
+			})
+		}
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"from":       fromKey,
+		"to":         toKey,
+		"totalCount": len(items),
+		"flags":      different,
+	})
+}
@@ -0,0 +1,162 @@
+package flags_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ldcli/cmd"
+	"ldcli/internal/analytics"
+	"ldcli/internal/errors"
+)
+
+func TestDiff(t *testing.T) {
+	t.Run("shows the differences for a flag", func(t *testing.T) {
+		client := &fakeClient{
+			responses: map[string][]byte{
+				"GET http://test.com/api/v2/flags/test-proj/test-flag": []byte(`{
+					"key": "test-flag",
+					"environments": {"staging": {"on": true, "offVariation": 1}, "production": {"on": false, "offVariation": 1}}
+				}`),
+			},
+		}
+		args := []string{
+			"flags", "diff",
+			"--access-token", "abcd1234",
+			"--base-uri", "http://test.com",
+			"--project", "test-proj",
+			"--flag", "test-flag",
+			"--from", "staging",
+			"--to", "production",
+		}
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: client,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, "test-flag is different in staging and production\n  on: true -> false\n", string(output))
+	})
+
+	t.Run("summarizes the differences for every flag", func(t *testing.T) {
+		client := &fakeClient{
+			responses: map[string][]byte{
+				"GET http://test.com/api/v2/flags/test-proj": []byte(`{"items": [
+				{"key": "same-flag", "environments": {"staging": {"on": true}, "production": {"on": true}}},
+				{
+					"key": "test-flag",
+					"environments": {
+						"staging": {"on": true, "fallthrough": {"variation": 0}},
+						"production": {"on": false, "fallthrough": {"variation": 1}}
+					}
+				}
+			], "totalCount": 2}`),
+			},
+		}
+		args := []string{
+			"flags", "diff",
+			"--access-token", "abcd1234",
+			"--base-uri", "http://test.com",
+			"--project", "test-proj",
+			"--all-flags",
+			"--from", "staging",
+			"--to", "production",
+		}
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: client,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, "1 of 2 flags are different in staging and production\n  test-flag: on, fallthrough\n", string(output))
+	})
+
+	t.Run("with --dry-run only shows the request", func(t *testing.T) {
+		args := []string{
+			"flags", "diff",
+			"--access-token", "abcd1234",
+			"--base-uri", "http://test.com",
+			"--project", "test-proj",
+			"--flag", "test-flag",
+			"--from", "staging",
+			"--to", "production",
+			"--dry-run",
+		}
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: &fakeClient{},
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.Contains(t, string(output), "GET http://test.com/api/v2/flags/test-proj/test-flag\n")
+		assert.NotContains(t, string(output), "is different")
+	})
+
+	t.Run("with a flag that doesn't exist", func(t *testing.T) {
+		client := &fakeClient{
+			errors: map[string]error{
+				"GET http://test.com/api/v2/flags/test-proj/test-flag": errors.NewResponseError(
+					404,
+					"",
+					[]byte(`{"code": "not_found", "message": "Unknown resource"}`),
+				),
+			},
+		}
+		args := []string{
+			"flags", "diff",
+			"--access-token", "abcd1234",
+			"--base-uri", "http://test.com",
+			"--project", "test-proj",
+			"--flag", "test-flag",
+			"--from", "staging",
+			"--to", "production",
+		}
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: client,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		assert.EqualError(t, err, "Unknown resource (code: not_found)")
+		assert.Equal(t, errors.ExitCodeNotFound, errors.ExitCode(err))
+	})
+
+	t.Run("with --flag and --all-flags is an error", func(t *testing.T) {
+		args := []string{
+			"flags", "diff",
+			"--access-token", "abcd1234",
+			"--base-uri", "http://test.com",
+			"--project", "test-proj",
+			"--flag", "test-flag",
+			"--all-flags",
+			"--from", "staging",
+			"--to", "production",
+		}
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: &fakeClient{},
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		assert.EqualError(t, err, "use either --flag or --all-flags")
+	})
+}
@@ -0,0 +1,60 @@
+package flags
+
+import (
+	"github.com/spf13/viper"
+
+	"ldcli/cmd/cliflags"
+	"ldcli/internal/flags"
+	"ldcli/internal/resources"
+)
+
+// flagEnvironments is a flag's targeting in the two environments a command compares.
+type flagEnvironments struct {
+	key  string
+	from map[string]interface{}
+	to   map[string]interface{}
+}
+
+// getFlagEnvironments gets the targeting in both environments for each flag. Without any flag
+// keys, it gets every flag in the project. The requests go through the client as is, so with the
+// dry-run or curl client, such as from diff, they're only printed and there aren't any flags.
+// Promote sends them with the request options even when it only prints its changes.
+func getFlagEnvironments(client resources.Client, flagKeys []string, fromKey string, toKey string) ([]flagEnvironments, error) {
+	accessToken := viper.GetString(cliflags.AccessTokenFlag)
+	baseURI := viper.GetString(cliflags.BaseURIFlag)
+	projKey := viper.GetString(cliflags.ProjectFlag)
+
+	var list []map[string]interface{}
+	if len(flagKeys) == 0 {
+		var err error
+		list, err = flags.ListFlags(client, accessToken, baseURI, projKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, key := range flagKeys {
+		flag, err := flags.GetFlag(client, accessToken, baseURI, projKey, key)
+		if err != nil {
+			return nil, err
+		}
+		if flag != nil {
+			list = append(list, flag)
+		}
+	}
+
+	items := make([]flagEnvironments, 0, len(list))
+	for _, flag := range list {
+		key, _ := flag["key"].(string)
+		from, err := flags.EnvironmentConfig(flag, fromKey)
+		if err != nil {
+			return nil, err
+		}
+		to, err := flags.EnvironmentConfig(flag, toKey)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, flagEnvironments{key: key, from: from, to: to})
+	}
+
+	return items, nil
+}
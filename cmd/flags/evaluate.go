@@ -0,0 +1,110 @@
+package flags
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"ldcli/cmd/cliflags"
+	resourcescmd "ldcli/cmd/resources"
+	"ldcli/cmd/validators"
+	"ldcli/internal/errors"
+	"ldcli/internal/evaluation"
+	"ldcli/internal/output"
+	"ldcli/internal/resources"
+)
+
+func NewEvaluateCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Args: validators.Validate(),
+		Long: `Evaluate a flag for a context without an SDK.
+
+Gets the flag's targeting, and the prerequisite flags and segments it uses, and evaluates the flag the same way the SDKs do. Shows the variation the context gets and the reason for it.
+
+The context can be JSON or YAML, such as '{"kind": "user", "key": "user-key-123abc"}'. To read it from a file, prefix the path with @, or use - to read it from stdin.`,
+		RunE:  runEvaluate(client),
+		Short: "Evaluate a flag for a context",
+		Use:   "evaluate",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(cliflags.ContextFlag, "", "The context to evaluate the flag for")
+	_ = cmd.MarkFlagRequired(cliflags.ContextFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ContextFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ContextFlag, cmd.Flags().Lookup(cliflags.ContextFlag))
+
+	cmd.Flags().String(cliflags.EnvironmentFlag, "", "The environment key")
+	_ = cmd.MarkFlagRequired(cliflags.EnvironmentFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.EnvironmentFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.EnvironmentFlag, cmd.Flags().Lookup(cliflags.EnvironmentFlag))
+
+	cmd.Flags().String(cliflags.FlagFlag, "", "The feature flag key")
+	_ = cmd.MarkFlagRequired(cliflags.FlagFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.FlagFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.FlagFlag, cmd.Flags().Lookup(cliflags.FlagFlag))
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	return cmd
+}
+
+func runEvaluate(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		// each request depends on the one before it, so they can't only be printed
+		if viper.GetBool(cliflags.DryRunFlag) || viper.GetBool(cliflags.PrintCurlFlag) {
+			return errors.NewError(fmt.Sprintf(
+				"`ldcli flags evaluate` doesn't support --%s or --%s",
+				cliflags.DryRunFlag,
+				cliflags.PrintCurlFlag,
+			))
+		}
+
+		data, err := resourcescmd.ReadData(viper.GetString(cliflags.ContextFlag), cmd.InOrStdin())
+		if err != nil {
+			return errors.NewError(fmt.Sprintf("context is invalid: %s", err))
+		}
+		context, err := evaluation.NewContext(data)
+		if err != nil {
+			return errors.NewError(fmt.Sprintf("context is invalid: %s", err))
+		}
+
+		source := evaluation.NewAPIDataSource(
+			resourcescmd.WithRequestOptions(client),
+			viper.GetString(cliflags.AccessTokenFlag),
+			viper.GetString(cliflags.BaseURIFlag),
+			viper.GetString(cliflags.ProjectFlag),
+			viper.GetString(cliflags.EnvironmentFlag),
+		)
+		flag, err := source.Flag(viper.GetString(cliflags.FlagFlag))
+		if err != nil {
+			return errors.NewErrorWrapped(output.CmdOutputError(viper.GetString(cliflags.OutputFlag), err), err)
+		}
+		result, err := evaluation.NewEvaluator(source).Evaluate(flag, context)
+		if err != nil {
+			return errors.NewErrorWrapped(output.CmdOutputError(viper.GetString(cliflags.OutputFlag), err), err)
+		}
+
+		res, err := json.Marshal(result)
+		if err != nil {
+			return errors.NewError(err.Error())
+		}
+		output, err := output.CmdOutputSingular(
+			viper.GetString(cliflags.OutputFlag),
+			res,
+			output.FlagEvaluationPlaintextOutputFn,
+		)
+		if err != nil {
+			return errors.NewError(err.Error())
+		}
+
+		fmt.Fprint(cmd.OutOrStdout(), output+"\n")
+
+		return nil
+	}
+}
@@ -0,0 +1,134 @@
+package flags_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ldcli/cmd"
+	"ldcli/internal/analytics"
+	"ldcli/internal/errors"
+)
+
+func TestEvaluate(t *testing.T) {
+	const flag = `{
+		"key": "test-flag",
+		"variations": [{"_id": "a", "value": "blue"}, {"_id": "b", "value": "green"}],
+		"environments": {
+			"production": {
+				"on": true,
+				"offVariation": 1,
+				"fallthrough": {"variation": 0},
+				"rules": [{
+					"_id": "rule-id",
+					"clauses": [{"attribute": "segmentMatch", "op": "segmentMatch", "values": ["beta-testers"]}],
+					"variation": 1
+				}]
+			}
+		}
+	}`
+	const segment = `{"key": "beta-testers", "included": ["user-key-123abc"]}`
+	baseArgs := []string{
+		"flags", "evaluate",
+		"--access-token", "abcd1234",
+		"--base-uri", "http://test.com",
+		"--project", "test-proj",
+		"--environment", "production",
+		"--flag", "test-flag",
+	}
+
+	t.Run("shows the variation and reason", func(t *testing.T) {
+		client := &fakeClient{
+			responses: map[string][]byte{
+				"GET http://test.com/api/v2/flags/test-proj/test-flag":                  []byte(flag),
+				"GET http://test.com/api/v2/segments/test-proj/production/beta-testers": []byte(segment),
+			},
+		}
+		args := append(baseArgs, "--context", `{"kind": "user", "key": "user-key-123abc"}`)
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: client,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, "test-flag: \"green\" (variation 1)\nReason: the context matched rule 1\n", string(output))
+	})
+
+	t.Run("with JSON output", func(t *testing.T) {
+		client := &fakeClient{
+			responses: map[string][]byte{
+				"GET http://test.com/api/v2/flags/test-proj/test-flag":                  []byte(flag),
+				"GET http://test.com/api/v2/segments/test-proj/production/beta-testers": []byte(segment),
+			},
+		}
+		args := append(baseArgs, "--context", `{"kind": "user", "key": "other-key"}`, "--output", "json")
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: client,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"flagKey": "test-flag",
+			"value": "blue",
+			"variationIndex": 0,
+			"reason": {"kind": "FALLTHROUGH"}
+		}`, string(output))
+	})
+
+	t.Run("with an invalid context", func(t *testing.T) {
+		args := append(baseArgs, "--context", `{"kind": "user"}`)
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: &fakeClient{},
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		assert.EqualError(t, err, "context is invalid: context for kind user must have a key")
+	})
+
+	t.Run("with a flag that doesn't exist", func(t *testing.T) {
+		client := &fakeClient{
+			errors: map[string]error{
+				"GET http://test.com/api/v2/flags/test-proj/test-flag": errors.NewError(`{"code": "not_found", "message": "Unknown resource"}`),
+			},
+		}
+		args := append(baseArgs, "--context", `{"kind": "user", "key": "user-key-123abc"}`)
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: client,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		assert.EqualError(t, err, "Unknown resource (code: not_found)")
+	})
+
+	t.Run("with --dry-run", func(t *testing.T) {
+		args := append(baseArgs, "--context", `{"kind": "user", "key": "user-key-123abc"}`, "--dry-run")
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: &fakeClient{},
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		assert.EqualError(t, err, "`ldcli flags evaluate` doesn't support --dry-run or --print-curl")
+	})
+}
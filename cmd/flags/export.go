@@ -0,0 +1,109 @@
+package flags
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"ldcli/cmd/cliflags"
+	resourcescmd "ldcli/cmd/resources"
+	"ldcli/cmd/validators"
+	"ldcli/internal/errors"
+	"ldcli/internal/flags"
+	"ldcli/internal/output"
+	"ldcli/internal/resources"
+)
+
+func NewExportCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Args: validators.Validate(),
+		Long: `Export each flag in a project to a file in a directory.
+
+Each file has a flag's variations, tags, and targeting in each environment. Edit the files and use ` + "`ldcli flags apply`" + ` to change the flags to match them.`,
+		RunE:  runExport(client),
+		Short: "Export flags to files",
+		Use:   "export",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(cliflags.DirFlag, "", "The directory to write the flag files to")
+	_ = cmd.MarkFlagRequired(cliflags.DirFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.DirFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.DirFlag, cmd.Flags().Lookup(cliflags.DirFlag))
+
+	cmd.Flags().String(cliflags.FormatFlag, flags.FileFormatYAML, "The format of the flag files, either yaml or json")
+	_ = viper.BindPFlag(cliflags.FormatFlag, cmd.Flags().Lookup(cliflags.FormatFlag))
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	return cmd
+}
+
+func runExport(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		format := viper.GetString(cliflags.FormatFlag)
+		if format != flags.FileFormatJSON && format != flags.FileFormatYAML {
+			return errors.NewError(fmt.Sprintf("format must be %s or %s", flags.FileFormatJSON, flags.FileFormatYAML))
+		}
+
+		requestClient, printOnly := resourcescmd.NewRequestClient(cmd, client)
+		items, err := flags.ListFlags(
+			requestClient,
+			viper.GetString(cliflags.AccessTokenFlag),
+			viper.GetString(cliflags.BaseURIFlag),
+			viper.GetString(cliflags.ProjectFlag),
+		)
+		if err != nil {
+			return errors.NewErrorWrapped(output.CmdOutputError(viper.GetString(cliflags.OutputFlag), err), err)
+		}
+		if printOnly {
+			return nil
+		}
+
+		dir := viper.GetString(cliflags.DirFlag)
+		err = os.MkdirAll(dir, 0755)
+		if err != nil {
+			return errors.NewError(err.Error())
+		}
+		keys := make([]string, 0, len(items))
+		for _, item := range items {
+			f := flags.NewFlagFile(item)
+			data, err := flags.MarshalFlagFile(f, format)
+			if err != nil {
+				return errors.NewError(err.Error())
+			}
+			err = os.WriteFile(flags.FlagFilePath(dir, f.Key, format), data, 0644)
+			if err != nil {
+				return errors.NewError(err.Error())
+			}
+			keys = append(keys, f.Key)
+		}
+
+		res, err := json.Marshal(map[string]interface{}{
+			"dir":   dir,
+			"flags": keys,
+		})
+		if err != nil {
+			return errors.NewError(err.Error())
+		}
+		output, err := output.CmdOutputSingular(
+			viper.GetString(cliflags.OutputFlag),
+			res,
+			output.FlagsExportPlaintextOutputFn,
+		)
+		if err != nil {
+			return errors.NewError(err.Error())
+		}
+
+		fmt.Fprint(cmd.OutOrStdout(), output+"\n")
+
+		return nil
+	}
+}
@@ -0,0 +1,108 @@
+package flags_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ldcli/cmd"
+	"ldcli/internal/analytics"
+	"ldcli/internal/resources"
+)
+
+func TestExport(t *testing.T) {
+	mockClient := &resources.MockClient{
+		Response: []byte(`{
+			"items": [
+				{
+					"key": "test-flag",
+					"name": "test flag",
+					"variations": [{"_id": "a", "value": true}, {"_id": "b", "value": false}],
+					"environments": {"production": {"on": true, "offVariation": 1}}
+				},
+				{"key": "other-flag", "name": "other flag"}
+			],
+			"totalCount": 2
+		}`),
+	}
+
+	t.Run("writes a yaml file for each flag", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "flags")
+		args := []string{
+			"flags", "export",
+			"--access-token", "abcd1234",
+			"--project", "test-proj",
+			"--dir", dir,
+		}
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: mockClient,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Exported 2 flags to "+dir+"\n", string(output))
+		data, err := os.ReadFile(filepath.Join(dir, "test-flag.yml"))
+		require.NoError(t, err)
+		assert.Equal(t, `key: test-flag
+name: test flag
+variations:
+  - value: true
+  - value: false
+environments:
+  production:
+    "on": true
+    offVariation: 1
+`, string(data))
+		assert.FileExists(t, filepath.Join(dir, "other-flag.yml"))
+	})
+
+	t.Run("writes json files", func(t *testing.T) {
+		dir := t.TempDir()
+		args := []string{
+			"flags", "export",
+			"--access-token", "abcd1234",
+			"--project", "test-proj",
+			"--dir", dir,
+			"--format", "json",
+		}
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: mockClient,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.FileExists(t, filepath.Join(dir, "test-flag.json"))
+		assert.FileExists(t, filepath.Join(dir, "other-flag.json"))
+	})
+
+	t.Run("with an invalid format", func(t *testing.T) {
+		args := []string{
+			"flags", "export",
+			"--access-token", "abcd1234",
+			"--project", "test-proj",
+			"--dir", t.TempDir(),
+			"--format", "xml",
+		}
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: mockClient,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		assert.EqualError(t, err, "format must be json or yaml")
+	})
+}
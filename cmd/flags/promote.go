@@ -0,0 +1,207 @@
+package flags
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"ldcli/cmd/cliflags"
+	resourcescmd "ldcli/cmd/resources"
+	"ldcli/cmd/validators"
+	"ldcli/internal/errors"
+	"ldcli/internal/flags"
+	"ldcli/internal/output"
+	"ldcli/internal/resources"
+)
+
+func NewPromoteCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Args: validators.Validate(),
+		Long: `Copy flags' targeting from one environment to another.
+
+Promotes whether each flag is on, its fallthrough, targets, and rules. Use --include to promote only some of them. The changes are shown and applied after you confirm them. Use --dry-run or --print-curl to only show the requests that make them, or --yes to apply them without confirming.`,
+		RunE:  runPromote(client),
+		Short: "Copy flags' targeting from one environment to another",
+		Use:   "promote",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().Bool(cliflags.AllFlagsFlag, false, "Promote every flag in the project")
+	_ = viper.BindPFlag(cliflags.AllFlagsFlag, cmd.Flags().Lookup(cliflags.AllFlagsFlag))
+
+	cmd.Flags().String(cliflags.CommentFlag, "", "The comment for the change. Defaults to the environment promoted from")
+	_ = viper.BindPFlag(cliflags.CommentFlag, cmd.Flags().Lookup(cliflags.CommentFlag))
+
+	cmd.Flags().StringSlice(cliflags.FlagFlag, nil, "The feature flag keys, either comma-separated or with a flag for each")
+	_ = viper.BindPFlag(cliflags.FlagFlag, cmd.Flags().Lookup(cliflags.FlagFlag))
+
+	cmd.Flags().String(cliflags.FromFlag, "", "The environment key to promote from")
+	_ = cmd.MarkFlagRequired(cliflags.FromFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.FromFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.FromFlag, cmd.Flags().Lookup(cliflags.FromFlag))
+
+	cmd.Flags().StringSlice(
+		cliflags.IncludeFlag,
+		flags.PromoteIncludes,
+		"The parts of the targeting to promote: on, fallthrough, targets, or rules",
+	)
+	_ = viper.BindPFlag(cliflags.IncludeFlag, cmd.Flags().Lookup(cliflags.IncludeFlag))
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	cmd.Flags().String(cliflags.ToFlag, "", "The environment key to promote to")
+	_ = cmd.MarkFlagRequired(cliflags.ToFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ToFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ToFlag, cmd.Flags().Lookup(cliflags.ToFlag))
+
+	cmd.Flags().Bool(cliflags.YesFlag, false, "Apply the changes without confirming them")
+	_ = viper.BindPFlag(cliflags.YesFlag, cmd.Flags().Lookup(cliflags.YesFlag))
+
+	return cmd
+}
+
+type promotedFlag struct {
+	Key   string              `json:"key"`
+	Patch []flags.UpdateInput `json:"patch"`
+}
+
+func runPromote(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		allFlags := viper.GetBool(cliflags.AllFlagsFlag)
+		flagKeys := viper.GetStringSlice(cliflags.FlagFlag)
+		if allFlags == (len(flagKeys) > 0) {
+			return errors.NewUsageError(errors.NewError(fmt.Sprintf(
+				"use either --%s or --%s",
+				cliflags.FlagFlag,
+				cliflags.AllFlagsFlag,
+			)))
+		}
+		includes := viper.GetStringSlice(cliflags.IncludeFlag)
+		err := flags.ValidatePromoteIncludes(includes)
+		if err != nil {
+			return errors.NewUsageError(err)
+		}
+
+		fromKey := viper.GetString(cliflags.FromFlag)
+		toKey := viper.GetString(cliflags.ToFlag)
+		// the flags are read even when the changes are only printed so the changes can be built
+		items, err := getFlagEnvironments(resourcescmd.WithRequestOptions(client), flagKeys, fromKey, toKey)
+		if err != nil {
+			return errors.NewErrorWrapped(output.CmdOutputError(viper.GetString(cliflags.OutputFlag), err), err)
+		}
+
+		promoted := make([]promotedFlag, 0, len(items))
+		for _, item := range items {
+			patch := flags.BuildPromotePatch(item.from, item.to, toKey, includes)
+			if len(patch) > 0 {
+				promoted = append(promoted, promotedFlag{Key: item.key, Patch: patch})
+			}
+		}
+
+		requestClient, printOnly := resourcescmd.NewRequestClient(cmd, client)
+		if len(promoted) > 0 {
+			if !printOnly && !viper.GetBool(cliflags.YesFlag) {
+				confirmed, err := confirmPromote(cmd, fromKey, toKey, promoted)
+				if err != nil {
+					return errors.NewError(err.Error())
+				}
+				if !confirmed {
+					return errors.NewError("nothing was promoted")
+				}
+			}
+
+			comment := viper.GetString(cliflags.CommentFlag)
+			if comment == "" {
+				comment = fmt.Sprintf("Promoted from %s", fromKey)
+			}
+			for _, p := range promoted {
+				data, err := json.Marshal(map[string]interface{}{
+					"patch":   p.Patch,
+					"comment": comment,
+				})
+				if err != nil {
+					return errors.NewError(err.Error())
+				}
+				_, err = requestClient.MakeRequest(
+					viper.GetString(cliflags.AccessTokenFlag),
+					"PATCH",
+					fmt.Sprintf(
+						"%s/api/v2/flags/%s/%s",
+						viper.GetString(cliflags.BaseURIFlag),
+						viper.GetString(cliflags.ProjectFlag),
+						p.Key,
+					),
+					"application/json",
+					nil,
+					data,
+				)
+				if err != nil {
+					return errors.NewErrorWrapped(output.CmdOutputError(viper.GetString(cliflags.OutputFlag), err), err)
+				}
+			}
+			if printOnly {
+				return nil
+			}
+		}
+
+		res, err := promoteResult(fromKey, toKey, promoted, true)
+		if err != nil {
+			return errors.NewError(err.Error())
+		}
+		output, err := output.CmdOutputSingular(
+			viper.GetString(cliflags.OutputFlag),
+			res,
+			output.FlagsPromotePlaintextOutputFn,
+		)
+		if err != nil {
+			return errors.NewError(err.Error())
+		}
+
+		fmt.Fprint(cmd.OutOrStdout(), output+"\n")
+
+		return nil
+	}
+}
+
+// confirmPromote shows the changes and asks to apply them. They're written to stderr so they
+// don't mix with JSON or YAML output.
+func confirmPromote(cmd *cobra.Command, fromKey, toKey string, promoted []promotedFlag) (bool, error) {
+	res, err := promoteResult(fromKey, toKey, promoted, false)
+	if err != nil {
+		return false, err
+	}
+	changes, err := output.CmdOutputSingular("plaintext", res, output.FlagsPromotePlaintextOutputFn)
+	if err != nil {
+		return false, err
+	}
+	fmt.Fprintf(cmd.ErrOrStderr(), "%s\n\nApply these changes to %s? [y/N] ", changes, toKey)
+
+	answer, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	if err != nil && answer == "" {
+		// no answer, such as when stdin is closed, isn't a confirmation
+		return false, nil
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+func promoteResult(fromKey, toKey string, promoted []promotedFlag, applied bool) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"from":    fromKey,
+		"to":      toKey,
+		"applied": applied,
+		"flags":   promoted,
+	})
+}
@@ -0,0 +1,154 @@
+package flags_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ldcli/cmd"
+	"ldcli/internal/analytics"
+	"ldcli/internal/errors"
+)
+
+func TestPromote(t *testing.T) {
+	const flag = `{
+		"key": "test-flag",
+		"environments": {
+			"staging": {"on": true, "fallthrough": {"variation": 1}},
+			"production": {"on": false, "fallthrough": {"variation": 1}}
+		}
+	}`
+	newClient := func() *fakeClient {
+		return &fakeClient{
+			responses: map[string][]byte{
+				"GET http://test.com/api/v2/flags/test-proj/test-flag": []byte(flag),
+			},
+		}
+	}
+	baseArgs := []string{
+		"flags", "promote",
+		"--access-token", "abcd1234",
+		"--base-uri", "http://test.com",
+		"--project", "test-proj",
+		"--flag", "test-flag",
+		"--from", "staging",
+		"--to", "production",
+	}
+
+	t.Run("applies the changes with --yes", func(t *testing.T) {
+		client := newClient()
+		args := append(baseArgs, "--yes")
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: client,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"GET http://test.com/api/v2/flags/test-proj/test-flag",
+			"PATCH http://test.com/api/v2/flags/test-proj/test-flag",
+		}, client.requests)
+		assert.JSONEq(t, `{
+			"patch": [{"op": "replace", "path": "/environments/production/on", "value": true}],
+			"comment": "Promoted from staging"
+		}`, string(client.inputs[1]))
+		assert.Equal(t, "Promoted 1 flag from staging to production\n  test-flag\n    replace /environments/production/on true\n", string(output))
+	})
+
+	t.Run("applies the changes with a comment", func(t *testing.T) {
+		client := newClient()
+		args := append(baseArgs, "--comment", "release 1.2", "--yes")
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: client,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		require.Len(t, client.inputs, 2)
+		assert.JSONEq(t, `{
+			"patch": [{"op": "replace", "path": "/environments/production/on", "value": true}],
+			"comment": "release 1.2"
+		}`, string(client.inputs[1]))
+	})
+
+	t.Run("only shows the requests with --dry-run", func(t *testing.T) {
+		client := newClient()
+		args := append(baseArgs, "--dry-run")
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: client,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"GET http://test.com/api/v2/flags/test-proj/test-flag"}, client.requests)
+		assert.Contains(t, string(output), "PATCH http://test.com/api/v2/flags/test-proj/test-flag\n")
+		assert.Contains(t, string(output), `"comment": "Promoted from staging"`)
+	})
+
+	t.Run("without changes to the included parts", func(t *testing.T) {
+		client := newClient()
+		args := append(baseArgs, "--include", "fallthrough,rules")
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: client,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"GET http://test.com/api/v2/flags/test-proj/test-flag"}, client.requests)
+		assert.Equal(t, "Nothing to promote from staging to production\n", string(output))
+	})
+
+	t.Run("with a conflict", func(t *testing.T) {
+		client := newClient()
+		client.errors = map[string]error{
+			"PATCH http://test.com/api/v2/flags/test-proj/test-flag": errors.NewResponseError(
+				409,
+				"",
+				[]byte(`{"code": "conflict", "message": "the flag was changed"}`),
+			),
+		}
+		args := append(baseArgs, "--yes")
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: client,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		assert.EqualError(t, err, "the flag was changed (code: conflict)")
+		assert.Equal(t, errors.ExitCodeConflict, errors.ExitCode(err))
+	})
+
+	t.Run("with an invalid --include is an error", func(t *testing.T) {
+		args := append(baseArgs, "--include", "variations")
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: &fakeClient{},
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		assert.EqualError(t, err, "variations can't be promoted. Use one or more of fallthrough, on, rules, targets")
+	})
+}
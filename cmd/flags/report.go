@@ -0,0 +1,165 @@
+package flags
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"ldcli/cmd/cliflags"
+	resourcescmd "ldcli/cmd/resources"
+	"ldcli/cmd/validators"
+	"ldcli/internal/errors"
+	"ldcli/internal/flags"
+	"ldcli/internal/output"
+	"ldcli/internal/resources"
+)
+
+func NewReportCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Long:  "Show reports about the flags in a project.",
+		Short: "Show reports about the flags in a project",
+		Use:   "report",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+	cmd.AddCommand(newReportStaleCmd(client))
+
+	return cmd
+}
+
+func newReportStaleCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Args: validators.Validate(),
+		Long: `Show the lifecycle stage of each flag in a project to find flags to clean up.
+
+Each flag's stage comes from its status in every environment, whether it's temporary, and its code references:
+- new: the flag was created in the last seven days and hasn't been evaluated
+- active: the flag is evaluated and serves more than one variation, is off, or was changed in the last seven days in at least one environment
+- launched: the flag is evaluated and only serves one variation, so it can be removed from the code
+- inactive: the flag hasn't been evaluated in any environment in the last seven days
+- removable: the flag is inactive and temporary, and doesn't have code references, so it can be archived
+
+Code references are only available on Enterprise plans. Without them, flags are inactive instead of removable.`,
+		RunE:  runReportStale(client),
+		Short: "Show flags that may be ready to clean up",
+		Use:   "stale",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	// this isn't the environment flag so the default environment from the config doesn't limit the report
+	cmd.Flags().String(cliflags.FilterEnvironmentFlag, "", "Only use the flag statuses in this environment")
+	_ = viper.BindPFlag(cliflags.FilterEnvironmentFlag, cmd.Flags().Lookup(cliflags.FilterEnvironmentFlag))
+
+	cmd.Flags().String(cliflags.OlderThanFlag, "", "Only show flags created more than this long ago, such as 90d")
+	_ = viper.BindPFlag(cliflags.OlderThanFlag, cmd.Flags().Lookup(cliflags.OlderThanFlag))
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	return cmd
+}
+
+func runReportStale(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		// the report needs every response, so the requests can't only be printed
+		if viper.GetBool(cliflags.DryRunFlag) || viper.GetBool(cliflags.PrintCurlFlag) {
+			return errors.NewError(fmt.Sprintf(
+				"`ldcli flags report stale` doesn't support --%s or --%s",
+				cliflags.DryRunFlag,
+				cliflags.PrintCurlFlag,
+			))
+		}
+
+		createdBefore := time.Now()
+		if olderThan := viper.GetString(cliflags.OlderThanFlag); olderThan != "" {
+			age, err := flags.ParseAge(olderThan)
+			if err != nil {
+				return errors.NewUsageError(err)
+			}
+			createdBefore = createdBefore.Add(-age)
+		}
+
+		report, err := staleReport(resourcescmd.WithRequestOptions(client), createdBefore)
+		if err != nil {
+			return errors.NewErrorWrapped(output.CmdOutputError(viper.GetString(cliflags.OutputFlag), err), err)
+		}
+
+		res, err := json.Marshal(report)
+		if err != nil {
+			return errors.NewError(err.Error())
+		}
+		output, err := output.CmdOutputSingular(
+			viper.GetString(cliflags.OutputFlag),
+			res,
+			output.FlagsStaleReportPlaintextOutputFn,
+		)
+		if err != nil {
+			return errors.NewError(err.Error())
+		}
+
+		fmt.Fprint(cmd.OutOrStdout(), output+"\n")
+
+		return nil
+	}
+}
+
+// staleReport gets the flags in the project, their statuses in each environment, and their code
+// references to build the report. Projects without code references still get a report.
+func staleReport(client resources.Client, createdBefore time.Time) (flags.StaleReport, error) {
+	accessToken := viper.GetString(cliflags.AccessTokenFlag)
+	baseURI := viper.GetString(cliflags.BaseURIFlag)
+	projKey := viper.GetString(cliflags.ProjectFlag)
+
+	items, err := flags.ListFlags(client, accessToken, baseURI, projKey)
+	if err != nil {
+		return flags.StaleReport{}, err
+	}
+
+	envKeys := []string{viper.GetString(cliflags.FilterEnvironmentFlag)}
+	if envKeys[0] == "" {
+		envKeys = flagEnvironmentKeys(items)
+	}
+	statuses := make(map[string]map[string]flags.FlagStatus, len(envKeys))
+	for _, envKey := range envKeys {
+		statuses[envKey], err = flags.ListFlagStatuses(client, accessToken, baseURI, projKey, envKey)
+		if err != nil {
+			return flags.StaleReport{}, err
+		}
+	}
+
+	codeReferences, err := flags.GetCodeReferenceCounts(client, accessToken, baseURI, projKey)
+	if errors.IsForbidden(err) || errors.IsNotFound(err) {
+		codeReferences, err = nil, nil
+	}
+	if err != nil {
+		return flags.StaleReport{}, err
+	}
+
+	return flags.NewStaleReport(items, statuses, codeReferences, createdBefore), nil
+}
+
+// flagEnvironmentKeys gets the keys of the environments the flags have targeting in, which are
+// all of the project's environments.
+func flagEnvironmentKeys(items []map[string]interface{}) []string {
+	keys := make(map[string]struct{})
+	for _, item := range items {
+		envs, _ := item["environments"].(map[string]interface{})
+		for k := range envs {
+			keys[k] = struct{}{}
+		}
+	}
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+
+	return sorted
+}
@@ -0,0 +1,172 @@
+package flags_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ldcli/cmd"
+	"ldcli/internal/analytics"
+	"ldcli/internal/errors"
+)
+
+func TestReportStale(t *testing.T) {
+	// 2024-01-01 and a day ago
+	flagsList := fmt.Sprintf(`{
+		"items": [
+			{"key": "old-flag", "name": "old flag", "temporary": true, "creationDate": 1704067200000, "environments": {"production": {}, "staging": {}}},
+			{"key": "unused-flag", "name": "unused flag", "temporary": true, "creationDate": 1704067200000, "environments": {"production": {}, "staging": {}}},
+			{"key": "recent-flag", "name": "recent flag", "temporary": true, "creationDate": %d, "environments": {"production": {}, "staging": {}}}
+		],
+		"totalCount": 3
+	}`, time.Now().Add(-24*time.Hour).UnixMilli())
+	const productionStatuses = `{"items": [
+		{"name": "launched", "lastRequested": "2024-03-01T00:00:00Z", "_links": {"parent": {"href": "/api/v2/flags/test-proj/old-flag"}}},
+		{"name": "inactive", "_links": {"parent": {"href": "/api/v2/flags/test-proj/unused-flag"}}},
+		{"name": "new", "_links": {"parent": {"href": "/api/v2/flags/test-proj/recent-flag"}}}
+	]}`
+	const stagingStatuses = `{"items": [
+		{"name": "inactive", "lastRequested": "2024-02-01T00:00:00Z", "_links": {"parent": {"href": "/api/v2/flags/test-proj/old-flag"}}},
+		{"name": "inactive", "_links": {"parent": {"href": "/api/v2/flags/test-proj/unused-flag"}}},
+		{"name": "new", "_links": {"parent": {"href": "/api/v2/flags/test-proj/recent-flag"}}}
+	]}`
+	const codeReferences = `{"flags": {"old-flag": [{"name": "repo-a", "hunkCount": 2}, {"name": "repo-b", "hunkCount": 1}]}}`
+	responses := map[string][]byte{
+		"GET http://test.com/api/v2/flags/test-proj":                    []byte(flagsList),
+		"GET http://test.com/api/v2/flag-statuses/test-proj/production": []byte(productionStatuses),
+		"GET http://test.com/api/v2/flag-statuses/test-proj/staging":    []byte(stagingStatuses),
+		"GET http://test.com/api/v2/code-refs/statistics/test-proj":     []byte(codeReferences),
+	}
+	baseArgs := []string{
+		"flags", "report", "stale",
+		"--access-token", "abcd1234",
+		"--base-uri", "http://test.com",
+		"--project", "test-proj",
+	}
+
+	t.Run("shows the stage of each flag", func(t *testing.T) {
+		client := &fakeClient{
+			responses: responses,
+		}
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: client,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			baseArgs,
+		)
+
+		require.NoError(t, err)
+		assert.Contains(t, string(output), "KEY           STAGE       TEMPORARY   CREATED")
+		assert.Contains(t, string(output), "unused-flag   removable   true        2024-01-01T00:00:00Z   never                  0\n")
+		assert.Contains(t, string(output), "old-flag      launched    true        2024-01-01T00:00:00Z   2024-03-01T00:00:00Z   3\n")
+		assert.Contains(t, string(output), "recent-flag   new")
+	})
+
+	t.Run("with --older-than", func(t *testing.T) {
+		client := &fakeClient{
+			responses: responses,
+		}
+		args := append(baseArgs, "--older-than", "90d", "--filter-environment", "staging", "--output", "json")
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: client,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.NotContains(t, client.requests, "GET http://test.com/api/v2/flag-statuses/test-proj/production")
+		assert.JSONEq(t, `{
+			"flags": [
+				{
+					"key": "unused-flag",
+					"name": "unused flag",
+					"stage": "removable",
+					"temporary": true,
+					"creationDate": 1704067200000,
+					"lastEvaluated": null,
+					"codeReferences": 0,
+					"statuses": {"staging": "inactive"}
+				},
+				{
+					"key": "old-flag",
+					"name": "old flag",
+					"stage": "inactive",
+					"temporary": true,
+					"creationDate": 1704067200000,
+					"lastEvaluated": 1706745600000,
+					"codeReferences": 3,
+					"statuses": {"staging": "inactive"}
+				}
+			],
+			"totalCount": 3
+		}`, string(output))
+	})
+
+	t.Run("uses every environment with a default environment", func(t *testing.T) {
+		t.Setenv("LD_ENVIRONMENT", "staging")
+		client := &fakeClient{
+			responses: responses,
+		}
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: client,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			baseArgs,
+		)
+
+		require.NoError(t, err)
+		assert.Contains(t, client.requests, "GET http://test.com/api/v2/flag-statuses/test-proj/production")
+		assert.Contains(t, client.requests, "GET http://test.com/api/v2/flag-statuses/test-proj/staging")
+	})
+
+	t.Run("without code references", func(t *testing.T) {
+		client := &fakeClient{
+			errors: map[string]error{
+				"GET http://test.com/api/v2/code-refs/statistics/test-proj": errors.NewResponseError(
+					403,
+					"",
+					[]byte(`{"code": "forbidden", "message": "Code references isn't available"}`),
+				),
+			},
+			responses: responses,
+		}
+		args := append(baseArgs, "--older-than", "90d")
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: client,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.Contains(t, string(output), "unused-flag   inactive   true        2024-01-01T00:00:00Z   never                  unknown\n")
+		assert.Contains(t, string(output), "Warning: code references aren't available for the project, so no flags are removable\n")
+	})
+
+	t.Run("with an invalid --older-than", func(t *testing.T) {
+		args := append(baseArgs, "--older-than", "soon")
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: &fakeClient{},
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		assert.EqualError(t, err, "soon isn't a valid age. Use a number of days, such as 90d")
+		assert.Equal(t, errors.ExitCodeUsage, errors.ExitCode(err))
+	})
+}
@@ -59,7 +59,8 @@ func runE(client resources.Client) func(*cobra.Command, []string) error {
 			viper.GetString(cliflags.ProjectFlag),
 			viper.GetString(cliflags.FlagFlag),
 		)
-		res, err := client.MakeRequest(
+		requestClient, printOnly := resourcescmd.NewRequestClient(cmd, client)
+		res, err := requestClient.MakeRequest(
 			viper.GetString(cliflags.AccessTokenFlag),
 			"PATCH",
 			path,
@@ -68,15 +69,26 @@ func runE(client resources.Client) func(*cobra.Command, []string) error {
 			[]byte(buildPatch(viper.GetString("environment"), toggleOn)),
 		)
 		if err != nil {
-			return errors.NewError(output.CmdOutputError(viper.GetString(cliflags.OutputFlag), err))
+			return errors.NewErrorWrapped(output.CmdOutputError(viper.GetString(cliflags.OutputFlag), err), err)
+		}
+		if printOnly {
+			return nil
 		}
 
-		output, err := output.CmdOutput("update", viper.GetString(cliflags.OutputFlag), res)
+		output, err := output.CmdOutput(
+			"update",
+			viper.GetString(cliflags.OutputFlag),
+			res,
+			output.CmdOutputOpts{
+				Columns: viper.GetStringSlice(cliflags.ColumnsFlag),
+				Query:   viper.GetString(cliflags.QueryFlag),
+			},
+		)
 		if err != nil {
 			return errors.NewError(err.Error())
 		}
 
-		fmt.Fprintf(cmd.OutOrStdout(), output+"\n")
+		fmt.Fprint(cmd.OutOrStdout(), output+"\n")
 
 		return nil
 	}
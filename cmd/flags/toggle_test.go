@@ -1,8 +1,11 @@
 package flags_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -38,3 +41,136 @@ func TestToggleOn(t *testing.T) {
 	assert.Equal(t, `[{"op": "replace", "path": "/environments/test-env/on", "value": true}]`, string(mockClient.Input))
 	assert.Equal(t, "Successfully updated test flag (test-flag)\n", string(output))
 }
+
+func TestToggleOnWithQuery(t *testing.T) {
+	mockClient := &resources.MockClient{
+		Response: []byte(`{
+			"key": "test-flag",
+			"name": "test flag",
+			"environments": {
+				"test-env": {"on": true}
+			}
+		}`),
+	}
+	args := []string{
+		"flags", "toggle-on",
+		"--access-token", "abcd1234",
+		"--environment", "test-env",
+		"--flag", "test-flag",
+		"--project", "test-proj",
+		"--query", `environments."test-env".on`,
+	}
+	output, err := cmd.CallCmd(
+		t,
+		cmd.APIClients{
+			ResourcesClient: mockClient,
+		},
+		analytics.NoopClientFn{}.Tracker(),
+		args,
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, "true\n", string(output))
+}
+
+func TestToggleOnWithDryRun(t *testing.T) {
+	mockClient := &resources.MockClient{}
+	args := []string{
+		"flags", "toggle-on",
+		"--access-token", "abcd1234",
+		"--base-uri", "http://test.com",
+		"--environment", "test-env",
+		"--flag", "test-flag",
+		"--project", "test-proj",
+		"--dry-run",
+	}
+	output, err := cmd.CallCmd(
+		t,
+		cmd.APIClients{
+			ResourcesClient: mockClient,
+		},
+		analytics.NoopClientFn{}.Tracker(),
+		args,
+	)
+
+	require.NoError(t, err)
+	assert.Nil(t, mockClient.Input)
+	assert.Equal(t, `PATCH http://test.com/api/v2/flags/test-proj/test-flag
+Authorization: [REDACTED]
+Content-Type: application/json
+User-Agent: launchdarkly-cli/vtest
+
+[
+  {
+    "op": "replace",
+    "path": "/environments/test-env/on",
+    "value": true
+  }
+]
+`, string(output))
+}
+
+func TestToggleOnWithPrintCurl(t *testing.T) {
+	mockClient := &resources.MockClient{}
+	args := []string{
+		"flags", "toggle-on",
+		"--access-token", "abcd1234",
+		"--base-uri", "http://test.com",
+		"--environment", "test-env",
+		"--flag", "test-flag",
+		"--project", "test-proj",
+		"--print-curl",
+	}
+	output, err := cmd.CallCmd(
+		t,
+		cmd.APIClients{
+			ResourcesClient: mockClient,
+		},
+		analytics.NoopClientFn{}.Tracker(),
+		args,
+	)
+
+	require.NoError(t, err)
+	assert.Nil(t, mockClient.Input)
+	assert.Equal(
+		t,
+		`curl -X PATCH 'http://test.com/api/v2/flags/test-proj/test-flag' `+
+			`-H "Authorization: $LD_ACCESS_TOKEN" `+
+			`-H 'Content-Type: application/json' `+
+			`-H 'User-Agent: launchdarkly-cli/vtest' `+
+			`--data-raw '[{"op": "replace", "path": "/environments/test-env/on", "value": true}]'`+"\n",
+		string(output),
+	)
+}
+
+func TestToggleOnWithConfigDefaults(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.yml")
+	require.NoError(t, os.WriteFile(configFile, []byte("project: test-proj\nenvironment: test-env\n"), 0600))
+	viper.SetConfigType("yml")
+	viper.SetConfigFile(configFile)
+	require.NoError(t, viper.ReadInConfig())
+	defer viper.Reset()
+
+	mockClient := &resources.MockClient{
+		Response: []byte(`{
+			"key": "test-flag",
+			"name": "test flag"
+		}`),
+	}
+	args := []string{
+		"flags", "toggle-on",
+		"--access-token", "abcd1234",
+		"--flag", "test-flag",
+	}
+	_, err := cmd.CallCmd(
+		t,
+		cmd.APIClients{
+			ResourcesClient: mockClient,
+		},
+		analytics.NoopClientFn{}.Tracker(),
+		args,
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, `[{"op": "replace", "path": "/environments/test-env/on", "value": true}]`, string(mockClient.Input))
+}
@@ -0,0 +1,180 @@
+package login
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"ldcli/cmd/cliflags"
+	resourcescmd "ldcli/cmd/resources"
+	"ldcli/cmd/validators"
+	"ldcli/internal/config"
+	"ldcli/internal/errors"
+	"ldcli/internal/login"
+	"ldcli/internal/output"
+	"ldcli/internal/resources"
+)
+
+func NewLoginCmd(client resources.Client) *cobra.Command {
+	return &cobra.Command{
+		Args: cobra.NoArgs,
+		Long: `Log in to LaunchDarkly without a browser on this machine.
+
+The command shows a code to enter at a URL on any device. After you approve the CLI, the access token is saved for the profile, in its credential store if it has one.`,
+		RunE:  runLogin(client),
+		Short: "Log in to LaunchDarkly",
+		Use:   "login",
+	}
+}
+
+func NewLogoutCmd(client resources.Client) *cobra.Command {
+	return &cobra.Command{
+		Args:  validators.Validate(),
+		Long:  "Revoke the access token and remove it from the config file or credential store",
+		RunE:  runLogout(client),
+		Short: "Log out of LaunchDarkly",
+		Use:   "logout",
+	}
+}
+
+func NewWhoAmICmd(client resources.Client) *cobra.Command {
+	return &cobra.Command{
+		Args:  validators.Validate(),
+		Long:  "Show the member, account, and scopes for the access token",
+		RunE:  runWhoAmI(client),
+		Short: "Show who the access token belongs to",
+		Use:   "whoami",
+	}
+}
+
+func runLogin(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		requestClient, err := newRequestClient(cmd, client)
+		if err != nil {
+			return err
+		}
+
+		baseURI := viper.GetString(cliflags.BaseURIFlag)
+		deviceAuth, err := login.RequestDeviceCode(requestClient, baseURI)
+		if err != nil {
+			return err
+		}
+
+		verificationURI := deviceAuth.VerificationURIComplete
+		if verificationURI == "" {
+			verificationURI = deviceAuth.VerificationURI
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "To log in, go to %s and enter the code %s\n", verificationURI, deviceAuth.UserCode)
+		fmt.Fprintln(cmd.OutOrStdout(), "Waiting for approval...")
+
+		token, err := login.PollForToken(requestClient, baseURI, deviceAuth, time.Sleep)
+		if err != nil {
+			return err
+		}
+
+		err = config.SetAccessToken(configFile(), viper.GetString(cliflags.ProfileFlag), token)
+		if err != nil {
+			return errors.NewError(err.Error())
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Logged in. The access token is saved for the %s profile.\n", profileName())
+
+		return nil
+	}
+}
+
+func runLogout(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		requestClient, err := newRequestClient(cmd, client)
+		if err != nil {
+			return err
+		}
+
+		err = login.RevokeToken(
+			requestClient,
+			viper.GetString(cliflags.BaseURIFlag),
+			viper.GetString(cliflags.AccessTokenFlag),
+		)
+		if err != nil {
+			return errors.NewErrorWrapped(fmt.Sprintf(
+				"%s. Use `ldcli config --unset %s` to remove it without revoking it",
+				err.Error(),
+				cliflags.AccessTokenFlag,
+			), err)
+		}
+
+		err = config.UnsetAccessToken(configFile(), viper.GetString(cliflags.ProfileFlag))
+		if err != nil {
+			return errors.NewError(err.Error())
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Logged out of the %s profile.\n", profileName())
+
+		return nil
+	}
+}
+
+func runWhoAmI(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		requestClient, err := newRequestClient(cmd, client)
+		if err != nil {
+			return err
+		}
+
+		res, err := login.WhoAmI(
+			requestClient,
+			viper.GetString(cliflags.AccessTokenFlag),
+			viper.GetString(cliflags.BaseURIFlag),
+		)
+		if err != nil {
+			return errors.NewErrorWrapped(output.CmdOutputError(viper.GetString(cliflags.OutputFlag), err), err)
+		}
+
+		output, err := output.CmdOutputSingular(
+			viper.GetString(cliflags.OutputFlag),
+			res,
+			output.WhoAmIPlaintextOutputFn,
+		)
+		if err != nil {
+			return errors.NewError(err.Error())
+		}
+
+		fmt.Fprint(cmd.OutOrStdout(), output+"\n")
+
+		return nil
+	}
+}
+
+// newRequestClient gets the client with the retry and timeout settings. These commands make more
+// than one request that depend on each other, so they can't only print them.
+func newRequestClient(cmd *cobra.Command, client resources.Client) (resources.Client, error) {
+	requestClient, printOnly := resourcescmd.NewRequestClient(cmd, client)
+	if printOnly {
+		return nil, errors.NewError(fmt.Sprintf(
+			"`ldcli %s` doesn't support --%s or --%s",
+			cmd.Name(),
+			cliflags.DryRunFlag,
+			cliflags.PrintCurlFlag,
+		))
+	}
+
+	return requestClient, nil
+}
+
+func configFile() string {
+	if viper.ConfigFileUsed() != "" {
+		return viper.ConfigFileUsed()
+	}
+
+	return config.GetConfigFile()
+}
+
+func profileName() string {
+	if viper.GetString(cliflags.ProfileFlag) == "" {
+		return config.DefaultProfile
+	}
+
+	return viper.GetString(cliflags.ProfileFlag)
+}
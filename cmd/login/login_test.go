@@ -0,0 +1,223 @@
+package login_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ldcli/cmd"
+	"ldcli/internal/analytics"
+	"ldcli/internal/resources"
+)
+
+// newServer stands in for LaunchDarkly's device authorization endpoints. The token endpoint
+// responds that authorization is pending the first time.
+func newServer(t *testing.T, tokenStatus int, tokenResponse string) *httptest.Server {
+	var tokenRequests int
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/device/code", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "ldcli", r.PostForm.Get("client_id"))
+		_, _ = w.Write([]byte(`{
+			"device_code": "test-device-code",
+			"user_code": "ABCD-EFGH",
+			"verification_uri": "https://app.launchdarkly.com/device",
+			"expires_in": 900,
+			"interval": 0
+		}`))
+	})
+	mux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "test-device-code", r.PostForm.Get("device_code"))
+		assert.Equal(t, "urn:ietf:params:oauth:grant-type:device_code", r.PostForm.Get("grant_type"))
+		tokenRequests++
+		if tokenRequests == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error": "authorization_pending"}`))
+			return
+		}
+		w.WriteHeader(tokenStatus)
+		_, _ = w.Write([]byte(tokenResponse))
+	})
+	mux.HandleFunc("/oauth/revoke", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "new-token", r.PostForm.Get("token"))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestLogin(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.yml")
+	viper.SetConfigType("yml")
+	viper.SetConfigFile(configFile)
+	defer viper.Reset()
+
+	t.Run("saves the access token after it's approved", func(t *testing.T) {
+		server := newServer(t, http.StatusOK, `{"access_token": "new-token", "token_type": "bearer"}`)
+		args := []string{
+			"login",
+			"--base-uri", server.URL,
+		}
+
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: resources.NewClient("test"),
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, `To log in, go to https://app.launchdarkly.com/device and enter the code ABCD-EFGH
+Waiting for approval...
+Logged in. The access token is saved for the default profile.
+`, string(output))
+		data, err := os.ReadFile(configFile)
+		require.NoError(t, err)
+		assert.Equal(t, "access-token: new-token\n", string(data))
+	})
+
+	t.Run("is an error when it's denied", func(t *testing.T) {
+		server := newServer(t, http.StatusBadRequest, `{"error": "access_denied"}`)
+		args := []string{
+			"login",
+			"--base-uri", server.URL,
+		}
+
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: resources.NewClient("test"),
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		assert.EqualError(t, err, "login was denied")
+	})
+
+	t.Run("revokes and removes the access token when logging out", func(t *testing.T) {
+		server := newServer(t, http.StatusOK, "")
+		require.NoError(t, viper.ReadInConfig())
+		args := []string{
+			"logout",
+			"--base-uri", server.URL,
+		}
+
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: resources.NewClient("test"),
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Logged out of the default profile.\n", string(output))
+		data, err := os.ReadFile(configFile)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "new-token")
+	})
+}
+
+func TestWhoAmI(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/caller-identity", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "test-token", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{
+			"accountId": "test-account",
+			"authKind": "token",
+			"memberId": "test-member",
+			"tokenId": "test-token-id",
+			"tokenKind": "personal",
+			"tokenName": "cli token"
+		}`))
+	})
+	mux.HandleFunc("/api/v2/members/test-member", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"_id": "test-member",
+			"email": "ada@example.com",
+			"firstName": "Ada",
+			"lastName": "Lovelace",
+			"role": "writer"
+		}`))
+	})
+	mux.HandleFunc("/api/v2/tokens/test-token-id", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"_id": "test-token-id", "role": "writer", "customRoleIds": ["flag-editor"]}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	t.Run("shows the member, account, and token scopes", func(t *testing.T) {
+		args := []string{
+			"whoami",
+			"--access-token", "test-token",
+			"--base-uri", server.URL,
+		}
+
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: resources.NewClient("test"),
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, `Member: Ada Lovelace (ada@example.com)
+Account: test-account
+Token: cli token (personal)
+Scopes: writer, flag-editor
+`, string(output))
+	})
+
+	t.Run("with JSON output", func(t *testing.T) {
+		args := []string{
+			"whoami",
+			"--access-token", "test-token",
+			"--base-uri", server.URL,
+			"--output", "json",
+		}
+
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: resources.NewClient("test"),
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"accountId": "test-account",
+			"authKind": "token",
+			"member": {
+				"_id": "test-member",
+				"email": "ada@example.com",
+				"firstName": "Ada",
+				"lastName": "Lovelace",
+				"role": "writer"
+			},
+			"token": {
+				"_id": "test-token-id",
+				"kind": "personal",
+				"name": "cli token",
+				"scopes": ["writer", "flag-editor"]
+			}
+		}`, string(output))
+	})
+}
@@ -49,7 +49,8 @@ func runE(client resources.Client) func(*cobra.Command, []string) error {
 			"%s/api/v2/members",
 			viper.GetString(cliflags.BaseURIFlag),
 		)
-		res, err := client.MakeRequest(
+		requestClient, printOnly := resourcescmd.NewRequestClient(cmd, client)
+		res, err := requestClient.MakeRequest(
 			viper.GetString(cliflags.AccessTokenFlag),
 			"POST",
 			path,
@@ -58,15 +59,26 @@ func runE(client resources.Client) func(*cobra.Command, []string) error {
 			membersJson,
 		)
 		if err != nil {
-			return errors.NewError(output.CmdOutputError(viper.GetString(cliflags.OutputFlag), err))
+			return errors.NewErrorWrapped(output.CmdOutputError(viper.GetString(cliflags.OutputFlag), err), err)
+		}
+		if printOnly {
+			return nil
 		}
 
-		output, err := output.CmdOutput("update", viper.GetString(cliflags.OutputFlag), res)
+		output, err := output.CmdOutput(
+			"update",
+			viper.GetString(cliflags.OutputFlag),
+			res,
+			output.CmdOutputOpts{
+				Columns: viper.GetStringSlice(cliflags.ColumnsFlag),
+				Query:   viper.GetString(cliflags.QueryFlag),
+			},
+		)
 		if err != nil {
 			return errors.NewError(err.Error())
 		}
 
-		fmt.Fprintf(cmd.OutOrStdout(), output+"\n")
+		fmt.Fprint(cmd.OutOrStdout(), output+"\n")
 
 		return nil
 	}
@@ -13,6 +13,7 @@ import (
 	"ldcli/cmd/cliflags"
 	"ldcli/cmd/validators"
 	"ldcli/internal/analytics"
+	"ldcli/internal/debug"
 	"ldcli/internal/environments"
 	"ldcli/internal/flags"
 	"ldcli/internal/quickstart"
@@ -45,19 +46,23 @@ func runQuickStart(
 	flagsClient flags.Client,
 ) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		f, err := tea.LogToFile("debug.log", "")
-		if err != nil {
-			fmt.Println("could not open file for debugging:", err)
-			os.Exit(1)
+		// the setup guide takes over the terminal, so debug logs go to a file instead of stderr
+		if debug.Enabled() {
+			f, err := tea.LogToFile("debug.log", "")
+			if err != nil {
+				fmt.Println("could not open file for debugging:", err)
+				os.Exit(1)
+			}
+			defer f.Close()
+			debug.SetOutput(f)
 		}
-		defer f.Close()
 
 		analyticsTracker := analyticsTrackerFn(
 			viper.GetString(cliflags.AccessTokenFlag),
 			viper.GetString(cliflags.BaseURIFlag),
 			viper.GetBool(cliflags.AnalyticsOptOut),
 		)
-		_, err = tea.NewProgram(quickstart.NewContainerModel(
+		_, err := tea.NewProgram(quickstart.NewContainerModel(
 			analyticsTracker,
 			environmentsClient,
 			flagsClient,
@@ -0,0 +1,54 @@
+package resources
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"ldcli/internal/errors"
+)
+
+// ReadData gets the request body from the data flag's value. The value can be inline JSON or YAML,
+// a path to a file prefixed with "@", or "-" to read from stdin.
+func ReadData(value string, stdin io.Reader) (interface{}, error) {
+	var (
+		raw []byte
+		err error
+	)
+	switch {
+	case value == "-":
+		raw, err = io.ReadAll(stdin)
+		if err != nil {
+			return nil, errors.NewError(fmt.Sprintf("could not read data from stdin: %s", err))
+		}
+	case strings.HasPrefix(value, "@"):
+		raw, err = os.ReadFile(strings.TrimPrefix(value, "@"))
+		if err != nil {
+			return nil, errors.NewError(fmt.Sprintf("could not read data from file: %s", err))
+		}
+	default:
+		raw = []byte(value)
+	}
+
+	var data interface{}
+	jsonErr := json.Unmarshal(raw, &data)
+	if jsonErr == nil {
+		return data, nil
+	}
+
+	// fall back to YAML, but keep the JSON error if the input isn't a YAML object or list
+	err = yaml.Unmarshal(raw, &data)
+	if err != nil {
+		return nil, jsonErr
+	}
+	switch data.(type) {
+	case map[string]interface{}, []interface{}:
+		return data, nil
+	default:
+		return nil, jsonErr
+	}
+}
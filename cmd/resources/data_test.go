@@ -0,0 +1,167 @@
+package resources_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ldcli/cmd"
+	"ldcli/internal/analytics"
+	"ldcli/internal/resources"
+)
+
+func TestDataFlag(t *testing.T) {
+	dir := t.TempDir()
+	jsonFile := filepath.Join(dir, "team.json")
+	require.NoError(t, os.WriteFile(jsonFile, []byte(`{"key": "team-key", "name": "Team Name"}`), 0600))
+	yamlFile := filepath.Join(dir, "team.yml")
+	require.NoError(t, os.WriteFile(yamlFile, []byte("key: team-key\nname: Team Name\n"), 0600))
+
+	tests := map[string]struct {
+		data  string
+		stdin string
+	}{
+		"with inline JSON": {
+			data: `{"key": "team-key", "name": "Team Name"}`,
+		},
+		"with inline YAML": {
+			data: "key: team-key\nname: Team Name",
+		},
+		"with a JSON file": {
+			data: "@" + jsonFile,
+		},
+		"with a YAML file": {
+			data: "@" + yamlFile,
+		},
+		"with stdin": {
+			data:  "-",
+			stdin: "key: team-key\nname: Team Name\n",
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			if tt.stdin != "" {
+				stdinFile := filepath.Join(t.TempDir(), "stdin")
+				require.NoError(t, os.WriteFile(stdinFile, []byte(tt.stdin), 0600))
+				f, err := os.Open(stdinFile)
+				require.NoError(t, err)
+				defer f.Close()
+
+				stdin := os.Stdin
+				os.Stdin = f
+				defer func() { os.Stdin = stdin }()
+			}
+
+			mockClient := &resources.MockClient{
+				Response: []byte(`{"key": "team-key", "name": "Team Name"}`),
+			}
+			args := []string{
+				"teams", "create",
+				"--access-token", "abcd1234",
+				"--data", tt.data,
+			}
+
+			output, err := cmd.CallCmd(
+				t,
+				cmd.APIClients{
+					ResourcesClient: mockClient,
+				},
+				analytics.NoopClientFn{}.Tracker(),
+				args,
+			)
+
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"key": "team-key", "name": "Team Name"}`, string(mockClient.Input))
+			assert.Equal(t, "Successfully created Team Name (team-key)\n", string(output))
+		})
+	}
+
+	t.Run("with a missing file", func(t *testing.T) {
+		args := []string{
+			"teams", "create",
+			"--access-token", "abcd1234",
+			"--data", "@" + filepath.Join(dir, "missing.json"),
+		}
+
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: &resources.MockClient{},
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		assert.ErrorContains(t, err, "could not read data from file")
+	})
+}
+
+func TestBodyFlags(t *testing.T) {
+	tests := map[string]struct {
+		args     []string
+		expected string
+	}{
+		"with only body flags": {
+			args: []string{
+				"--key", "team-key",
+				"--name", "Team Name",
+				"--member-ids", "id-1,id-2",
+			},
+			expected: `{"key": "team-key", "name": "Team Name", "memberIDs": ["id-1", "id-2"]}`,
+		},
+		"with body flags and data": {
+			args: []string{
+				"--data", `{"key": "team-key", "name": "Old Name", "description": "A team"}`,
+				"--name", "Team Name",
+			},
+			expected: `{"key": "team-key", "name": "Team Name", "description": "A team"}`,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			mockClient := &resources.MockClient{
+				Response: []byte(`{"key": "team-key", "name": "Team Name"}`),
+			}
+			args := append([]string{
+				"teams", "create",
+				"--access-token", "abcd1234",
+			}, tt.args...)
+
+			_, err := cmd.CallCmd(
+				t,
+				cmd.APIClients{
+					ResourcesClient: mockClient,
+				},
+				analytics.NoopClientFn{}.Tracker(),
+				args,
+			)
+
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expected, string(mockClient.Input))
+		})
+	}
+
+	t.Run("without a required body flag or data", func(t *testing.T) {
+		args := []string{
+			"teams", "create",
+			"--access-token", "abcd1234",
+			"--key", "team-key",
+		}
+
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: &resources.MockClient{},
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		assert.ErrorContains(t, err, "at least one of the flags in the group [name data] is required")
+	})
+}
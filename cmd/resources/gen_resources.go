@@ -18,6 +18,8 @@ const (
 	pathTemplate = "resources/resource_cmds.tmpl"
 	templateName = "resource_cmds.tmpl"
 	pathOutput   = "resources/resource_cmds.go"
+
+	pathRequestSchemasOutput = "resources/request_schemas.json"
 )
 
 func main() {
@@ -50,4 +52,15 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
+
+	requestSchemas, err := resources.GetRequestSchemas(pathSpecFile)
+	if err != nil {
+		panic(err)
+	}
+
+	log.Printf("writing %s\n", pathRequestSchemasOutput)
+	err = ioutil.WriteFile(pathRequestSchemasOutput, requestSchemas, 0644)
+	if err != nil {
+		panic(err)
+	}
 }
@@ -0,0 +1,107 @@
+package resources
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"ldcli/internal/resources"
+)
+
+// paginatedResponse is the subset of a list response we need to request every page.
+type paginatedResponse struct {
+	Items      []interface{}                `json:"items"`
+	Links      map[string]map[string]string `json:"_links,omitempty"`
+	TotalCount int                          `json:"totalCount"`
+}
+
+// makePaginatedRequest requests each page of a list response until there are no more results and
+// combines the items from every page into a single response.
+func makePaginatedRequest(
+	client resources.Client,
+	accessToken, method, path, contentType string,
+	query url.Values,
+	data []byte,
+) ([]byte, error) {
+	items := make([]interface{}, 0)
+	var totalCount int
+	for {
+		res, err := client.MakeRequest(accessToken, method, path, contentType, query, data)
+		if err != nil {
+			return nil, err
+		}
+
+		var page paginatedResponse
+		err = json.Unmarshal(res, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, page.Items...)
+		totalCount = page.TotalCount
+		if len(page.Items) == 0 || (totalCount > 0 && len(items) >= totalCount) {
+			break
+		}
+
+		var ok bool
+		path, query, ok = nextPage(path, page)
+		if !ok {
+			break
+		}
+	}
+
+	if totalCount < len(items) {
+		totalCount = len(items)
+	}
+
+	return json.Marshal(paginatedResponse{
+		Items:      items,
+		TotalCount: totalCount,
+	})
+}
+
+// nextPage returns the path and query for the page after the given one. It prefers the next link
+// from the response and falls back to incrementing the offset from the self link.
+func nextPage(path string, page paginatedResponse) (string, url.Values, bool) {
+	current, err := url.Parse(path)
+	if err != nil {
+		return "", nil, false
+	}
+
+	if next, ok := page.Links["next"]; ok && next["href"] != "" {
+		nextURL, err := url.Parse(next["href"])
+		if err != nil {
+			return "", nil, false
+		}
+
+		return withoutQuery(current.ResolveReference(nextURL)), nextURL.Query(), true
+	}
+
+	self, ok := page.Links["self"]
+	if !ok || page.TotalCount == 0 {
+		return "", nil, false
+	}
+	selfURL, err := url.Parse(self["href"])
+	if err != nil {
+		return "", nil, false
+	}
+
+	query := selfURL.Query()
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	if limit == 0 {
+		limit = len(page.Items)
+	}
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	if offset+limit >= page.TotalCount {
+		return "", nil, false
+	}
+	query.Set("offset", strconv.Itoa(offset+limit))
+
+	return withoutQuery(current.ResolveReference(selfURL)), query, true
+}
+
+func withoutQuery(u *url.URL) string {
+	u.RawQuery = ""
+
+	return u.String()
+}
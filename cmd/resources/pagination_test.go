@@ -0,0 +1,106 @@
+package resources_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ldcli/cmd"
+	"ldcli/internal/analytics"
+	"ldcli/internal/resources"
+)
+
+func TestListAll(t *testing.T) {
+	t.Run("follows the next link until there are no more pages", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Query().Get("offset") {
+			case "":
+				fmt.Fprint(w, `{
+					"_links": {
+						"next": {"href": "/api/v2/teams?limit=1&offset=1"},
+						"self": {"href": "/api/v2/teams?limit=1"}
+					},
+					"items": [{"key": "team-1", "name": "Team 1"}],
+					"totalCount": 2
+				}`)
+			case "1":
+				fmt.Fprint(w, `{
+					"_links": {
+						"self": {"href": "/api/v2/teams?limit=1&offset=1"}
+					},
+					"items": [{"key": "team-2", "name": "Team 2"}],
+					"totalCount": 2
+				}`)
+			default:
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		}))
+		defer server.Close()
+
+		args := []string{
+			"teams", "list",
+			"--access-token", "abcd1234",
+			"--base-uri", server.URL,
+			"--all",
+		}
+
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: resources.NewClient("test"),
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, "* Team 1 (team-1)\n* Team 2 (team-2)\n", string(output))
+	})
+
+	t.Run("falls back to the offset from the self link", func(t *testing.T) {
+		var requestedOffsets []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			offset := r.URL.Query().Get("offset")
+			requestedOffsets = append(requestedOffsets, offset)
+			if offset == "" {
+				offset = "0"
+			}
+			fmt.Fprintf(w, `{
+				"_links": {
+					"self": {"href": "/api/v2/teams?limit=1&offset=%s"}
+				},
+				"items": [{"key": "team-%s"}],
+				"totalCount": 3
+			}`, offset, offset)
+		}))
+		defer server.Close()
+
+		args := []string{
+			"teams", "list",
+			"--access-token", "abcd1234",
+			"--base-uri", server.URL,
+			"--all",
+			"--output", "json",
+		}
+
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: resources.NewClient("test"),
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"", "1", "2"}, requestedOffsets)
+		assert.JSONEq(t, `{
+			"items": [{"key": "team-0"}, {"key": "team-1"}, {"key": "team-2"}],
+			"totalCount": 3
+		}`, string(output))
+	})
+}
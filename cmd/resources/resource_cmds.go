@@ -217,6 +217,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/approval-requests/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_ApprovalRequestsResourceCmd, client, OperationData{
@@ -254,6 +256,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects/{projectKey}/flags/{featureFlagKey}/environments/{environmentKey}/approval-requests/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_ApprovalRequestsResourceCmd, client, OperationData{
@@ -291,6 +295,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects/{projectKey}/flags/{featureFlagKey}/environments/{environmentKey}/approval-requests/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_ApprovalRequestsResourceCmd, client, OperationData{
@@ -316,6 +322,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/approval-requests/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_ApprovalRequestsResourceCmd, client, OperationData{
@@ -353,6 +361,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/approval-requests",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_ApprovalRequestsResourceCmd, client, OperationData{
@@ -384,6 +394,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects/{projectKey}/flags/{featureFlagKey}/environments/{environmentKey}/approval-requests",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_ApprovalRequestsResourceCmd, client, OperationData{
@@ -396,6 +408,44 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/approval-requests",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "comment",
+				In:          "body",
+				Description: "Optional comment describing the approval request",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "description",
+				In:          "body",
+				Description: "A brief description of the changes you're requesting",
+				Type:        "string",
+				Required:    true,
+			},
+			{
+				Name:        "notifyMemberIds",
+				In:          "body",
+				Description: "An array of member IDs. These members are notified to review the approval request.",
+				Type:        "array",
+				Required:    false,
+			},
+			{
+				Name:        "notifyTeamKeys",
+				In:          "body",
+				Description: "An array of team keys. The members of these teams are notified to review the approval request.",
+				Type:        "array",
+				Required:    false,
+			},
+			{
+				Name:        "resourceId",
+				In:          "body",
+				Description: "String representation of a resource",
+				Type:        "string",
+				Required:    true,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_ApprovalRequestsResourceCmd, client, OperationData{
@@ -415,6 +465,16 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/approval-requests/{id}/apply",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "comment",
+				In:          "body",
+				Description: "Optional comment about the approval request",
+				Type:        "string",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_ApprovalRequestsResourceCmd, client, OperationData{
@@ -452,6 +512,16 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/projects/{projectKey}/flags/{featureFlagKey}/environments/{environmentKey}/approval-requests/{id}/apply",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "comment",
+				In:          "body",
+				Description: "Optional comment about the approval request",
+				Type:        "string",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_ApprovalRequestsResourceCmd, client, OperationData{
@@ -483,6 +553,51 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/projects/{projectKey}/flags/{featureFlagKey}/environments/{environmentKey}/approval-requests",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "comment",
+				In:          "body",
+				Description: "Optional comment describing the approval request",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "description",
+				In:          "body",
+				Description: "A brief description of the changes you're requesting",
+				Type:        "string",
+				Required:    true,
+			},
+			{
+				Name:        "executionDate",
+				In:          "body",
+				Description: "",
+				Type:        "integer",
+				Required:    false,
+			},
+			{
+				Name:        "notifyMemberIds",
+				In:          "body",
+				Description: "An array of member IDs. These members are notified to review the approval request.",
+				Type:        "array",
+				Required:    false,
+			},
+			{
+				Name:        "notifyTeamKeys",
+				In:          "body",
+				Description: "An array of team keys. The members of these teams are notified to review the approval request.",
+				Type:        "array",
+				Required:    false,
+			},
+			{
+				Name:        "operatingOnId",
+				In:          "body",
+				Description: "The ID of a scheduled change. Include this if your \u003ccode\u003einstructions\u003c/code\u003e include editing or deleting a scheduled change.",
+				Type:        "string",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_ApprovalRequestsResourceCmd, client, OperationData{
@@ -502,6 +617,23 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/approval-requests/{id}/reviews",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "comment",
+				In:          "body",
+				Description: "Optional comment about the approval request",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "kind",
+				In:          "body",
+				Description: "The type of review for this approval request",
+				Type:        "string",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_ApprovalRequestsResourceCmd, client, OperationData{
@@ -539,6 +671,23 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/projects/{projectKey}/flags/{featureFlagKey}/environments/{environmentKey}/approval-requests/{id}/reviews",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "comment",
+				In:          "body",
+				Description: "Optional comment about the approval request",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "kind",
+				In:          "body",
+				Description: "The type of review for this approval request",
+				Type:        "string",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_ApprovalRequestsResourceCmd, client, OperationData{
@@ -570,6 +719,51 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/projects/{projectKey}/flags/{featureFlagKey}/environments/{environmentKey}/approval-requests-flag-copy",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "comment",
+				In:          "body",
+				Description: "Optional comment describing the approval request",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "description",
+				In:          "body",
+				Description: "A brief description of your changes",
+				Type:        "string",
+				Required:    true,
+			},
+			{
+				Name:        "excludedActions",
+				In:          "body",
+				Description: "Optional list of the flag changes NOT to copy from the source environment to the target environment. You may include either \u003ccode\u003eincludedActions\u003c/code\u003e or \u003ccode\u003eexcludedActions\u003c/code\u003e, but not both. If neither are included, then all flag changes will be copied.",
+				Type:        "array",
+				Required:    false,
+			},
+			{
+				Name:        "includedActions",
+				In:          "body",
+				Description: "Optional list of the flag changes to copy from the source environment to the target environment. You may include either \u003ccode\u003eincludedActions\u003c/code\u003e or \u003ccode\u003eexcludedActions\u003c/code\u003e, but not both. If neither are included, then all flag changes will be copied.",
+				Type:        "array",
+				Required:    false,
+			},
+			{
+				Name:        "notifyMemberIds",
+				In:          "body",
+				Description: "An array of member IDs. These members are notified to review the approval request.",
+				Type:        "array",
+				Required:    false,
+			},
+			{
+				Name:        "notifyTeamKeys",
+				In:          "body",
+				Description: "An array of team keys. The members of these teams are notified to review the approval request.",
+				Type:        "array",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_AuditLogResourceCmd, client, OperationData{
@@ -613,6 +807,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/auditlog",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_AuditLogResourceCmd, client, OperationData{
@@ -632,6 +828,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/auditlog/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_CodeRefsResourceCmd, client, OperationData{
@@ -651,6 +849,8 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/code-refs/repositories/{repo}/branch-delete-tasks",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_CodeRefsResourceCmd, client, OperationData{
@@ -670,6 +870,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/code-refs/repositories/{repo}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_CodeRefsResourceCmd, client, OperationData{
@@ -707,6 +909,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/code-refs/repositories/{repo}/branches/{branch}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_CodeRefsResourceCmd, client, OperationData{
@@ -726,6 +930,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/code-refs/repositories/{repo}/branches",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_CodeRefsResourceCmd, client, OperationData{
@@ -775,6 +981,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/code-refs/extinctions",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_CodeRefsResourceCmd, client, OperationData{
@@ -812,6 +1020,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/code-refs/repositories",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_CodeRefsResourceCmd, client, OperationData{
@@ -831,6 +1041,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/code-refs/repositories/{repo}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_CodeRefsResourceCmd, client, OperationData{
@@ -843,6 +1055,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/code-refs/statistics",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_CodeRefsResourceCmd, client, OperationData{
@@ -868,6 +1082,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/code-refs/statistics/{projectKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_CodeRefsResourceCmd, client, OperationData{
@@ -887,6 +1103,8 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/code-refs/repositories/{repo}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_CodeRefsResourceCmd, client, OperationData{
@@ -912,6 +1130,8 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/code-refs/repositories/{repo}/branches/{branch}/extinction-events",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_CodeRefsResourceCmd, client, OperationData{
@@ -924,6 +1144,51 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/code-refs/repositories",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "commitUrlTemplate",
+				In:          "body",
+				Description: "A template for constructing a valid URL to view the commit",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "defaultBranch",
+				In:          "body",
+				Description: "The repository's default branch. If not specified, the default value is \u003ccode\u003emain\u003c/code\u003e.",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "hunkUrlTemplate",
+				In:          "body",
+				Description: "A template for constructing a valid URL to view the hunk",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "name",
+				In:          "body",
+				Description: "The repository name",
+				Type:        "string",
+				Required:    true,
+			},
+			{
+				Name:        "sourceLink",
+				In:          "body",
+				Description: "A URL to access the repository",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "type",
+				In:          "body",
+				Description: "The type of repository. If not specified, the default value is \u003ccode\u003ecustom\u003c/code\u003e.",
+				Type:        "string",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_CodeRefsResourceCmd, client, OperationData{
@@ -949,6 +1214,44 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/code-refs/repositories/{repo}/branches/{branch}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "commitTime",
+				In:          "body",
+				Description: "",
+				Type:        "integer",
+				Required:    false,
+			},
+			{
+				Name:        "head",
+				In:          "body",
+				Description: "An ID representing the branch HEAD. For example, a commit SHA.",
+				Type:        "string",
+				Required:    true,
+			},
+			{
+				Name:        "name",
+				In:          "body",
+				Description: "The branch name",
+				Type:        "string",
+				Required:    true,
+			},
+			{
+				Name:        "syncTime",
+				In:          "body",
+				Description: "",
+				Type:        "integer",
+				Required:    true,
+			},
+			{
+				Name:        "updateSequenceId",
+				In:          "body",
+				Description: "An optional ID used to prevent older data from overwriting newer data. If no sequence ID is included, the newly submitted data will always be saved.",
+				Type:        "integer",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_ContextSettingsResourceCmd, client, OperationData{
@@ -992,6 +1295,16 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/projects/{projectKey}/environments/{environmentKey}/contexts/{contextKind}/{contextKey}/flags/{featureFlagKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "comment",
+				In:          "body",
+				Description: "Optional comment describing the change",
+				Type:        "string",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_ContextsResourceCmd, client, OperationData{
@@ -1023,6 +1336,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects/{projectKey}/environments/{environmentKey}/context-instances/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_ContextsResourceCmd, client, OperationData{
@@ -1072,6 +1387,8 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/projects/{projectKey}/environments/{environmentKey}/flags/evaluate",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_ContextsResourceCmd, client, OperationData{
@@ -1103,6 +1420,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects/{projectKey}/environments/{environmentKey}/context-attributes",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_ContextsResourceCmd, client, OperationData{
@@ -1140,6 +1459,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects/{projectKey}/environments/{environmentKey}/context-attributes/{attributeName}",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_ContextsResourceCmd, client, OperationData{
@@ -1201,6 +1522,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects/{projectKey}/environments/{environmentKey}/context-instances/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_ContextsResourceCmd, client, OperationData{
@@ -1220,6 +1543,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects/{projectKey}/context-kinds",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_ContextsResourceCmd, client, OperationData{
@@ -1287,6 +1612,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects/{projectKey}/environments/{environmentKey}/contexts/{kind}/{key}",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_ContextsResourceCmd, client, OperationData{
@@ -1312,6 +1639,44 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/projects/{projectKey}/context-kinds/{key}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "archived",
+				In:          "body",
+				Description: "Whether the context kind is archived. Archived context kinds are unavailable for targeting.",
+				Type:        "boolean",
+				Required:    false,
+			},
+			{
+				Name:        "description",
+				In:          "body",
+				Description: "The context kind description",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "hideInTargeting",
+				In:          "body",
+				Description: "Alias for archived.",
+				Type:        "boolean",
+				Required:    false,
+			},
+			{
+				Name:        "name",
+				In:          "body",
+				Description: "The context kind name",
+				Type:        "string",
+				Required:    true,
+			},
+			{
+				Name:        "version",
+				In:          "body",
+				Description: "The context kind version. If not specified when the context kind is created, defaults to 1.",
+				Type:        "integer",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_ContextsResourceCmd, client, OperationData{
@@ -1367,6 +1732,8 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/projects/{projectKey}/environments/{environmentKey}/context-instances/search",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_ContextsResourceCmd, client, OperationData{
@@ -1422,6 +1789,8 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/projects/{projectKey}/environments/{environmentKey}/contexts/search",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_CustomRolesResourceCmd, client, OperationData{
@@ -1441,6 +1810,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/roles/{customRoleKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_CustomRolesResourceCmd, client, OperationData{
@@ -1460,6 +1831,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/roles/{customRoleKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_CustomRolesResourceCmd, client, OperationData{
@@ -1485,6 +1858,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/roles",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_CustomRolesResourceCmd, client, OperationData{
@@ -1504,6 +1879,16 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/roles/{customRoleKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "comment",
+				In:          "body",
+				Description: "Optional comment",
+				Type:        "string",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_CustomRolesResourceCmd, client, OperationData{
@@ -1516,6 +1901,37 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/roles",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "basePermissions",
+				In:          "body",
+				Description: "",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "description",
+				In:          "body",
+				Description: "Description of custom role",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "key",
+				In:          "body",
+				Description: "The custom role key",
+				Type:        "string",
+				Required:    true,
+			},
+			{
+				Name:        "name",
+				In:          "body",
+				Description: "A human-friendly name for the custom role",
+				Type:        "string",
+				Required:    true,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_DataExportDestinationsResourceCmd, client, OperationData{
@@ -1547,6 +1963,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/destinations/{projectKey}/{environmentKey}/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_DataExportDestinationsResourceCmd, client, OperationData{
@@ -1578,6 +1996,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/destinations/{projectKey}/{environmentKey}/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_DataExportDestinationsResourceCmd, client, OperationData{
@@ -1590,6 +2010,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/destinations",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_DataExportDestinationsResourceCmd, client, OperationData{
@@ -1621,6 +2043,8 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/destinations/{projectKey}/{environmentKey}/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_DataExportDestinationsResourceCmd, client, OperationData{
@@ -1646,6 +2070,30 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/destinations/{projectKey}/{environmentKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "kind",
+				In:          "body",
+				Description: "The type of Data Export destination",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "name",
+				In:          "body",
+				Description: "A human-readable name for your Data Export destination",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "on",
+				In:          "body",
+				Description: "Whether the export is on. Displayed as the integration status in the LaunchDarkly UI.",
+				Type:        "boolean",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_EnvironmentsResourceCmd, client, OperationData{
@@ -1671,6 +2119,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects/{projectKey}/environments/{environmentKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_EnvironmentsResourceCmd, client, OperationData{
@@ -1696,6 +2146,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects/{projectKey}/environments/{environmentKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_EnvironmentsResourceCmd, client, OperationData{
@@ -1739,6 +2191,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects/{projectKey}/environments",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_EnvironmentsResourceCmd, client, OperationData{
@@ -1764,6 +2218,8 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/projects/{projectKey}/environments/{environmentKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_EnvironmentsResourceCmd, client, OperationData{
@@ -1783,6 +2239,79 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/projects/{projectKey}/environments",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "color",
+				In:          "body",
+				Description: "A color to indicate this environment in the UI",
+				Type:        "string",
+				Required:    true,
+			},
+			{
+				Name:        "confirmChanges",
+				In:          "body",
+				Description: "Requires confirmation for all flag and segment changes via the UI in this environment",
+				Type:        "boolean",
+				Required:    false,
+			},
+			{
+				Name:        "critical",
+				In:          "body",
+				Description: "Whether the environment is critical",
+				Type:        "boolean",
+				Required:    false,
+			},
+			{
+				Name:        "defaultTrackEvents",
+				In:          "body",
+				Description: "Enables tracking detailed information for new flags by default",
+				Type:        "boolean",
+				Required:    false,
+			},
+			{
+				Name:        "defaultTtl",
+				In:          "body",
+				Description: "The default time (in minutes) that the PHP SDK can cache feature flag rules locally",
+				Type:        "integer",
+				Required:    false,
+			},
+			{
+				Name:        "key",
+				In:          "body",
+				Description: "A project-unique key for the new environment",
+				Type:        "string",
+				Required:    true,
+			},
+			{
+				Name:        "name",
+				In:          "body",
+				Description: "A human-friendly name for the new environment",
+				Type:        "string",
+				Required:    true,
+			},
+			{
+				Name:        "requireComments",
+				In:          "body",
+				Description: "Requires comments for all flag and segment changes via the UI in this environment",
+				Type:        "boolean",
+				Required:    false,
+			},
+			{
+				Name:        "secureMode",
+				In:          "body",
+				Description: "Ensures that one end user of the client-side SDK cannot inspect the variations for another end user",
+				Type:        "boolean",
+				Required:    false,
+			},
+			{
+				Name:        "tags",
+				In:          "body",
+				Description: "Tags to apply to the new environment",
+				Type:        "array",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_EnvironmentsResourceCmd, client, OperationData{
@@ -1808,6 +2337,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects/{projectKey}/environments/{environmentKey}/mobileKey",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_EnvironmentsResourceCmd, client, OperationData{
@@ -1839,6 +2370,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects/{projectKey}/environments/{environmentKey}/apiKey",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_FlagTriggersResourceCmd, client, OperationData{
@@ -1870,6 +2403,23 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/flags/{projectKey}/{featureFlagKey}/triggers/{environmentKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "comment",
+				In:          "body",
+				Description: "Optional comment describing the trigger",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "integrationKey",
+				In:          "body",
+				Description: "The unique identifier of the integration for your trigger. Use \u003ccode\u003egeneric-trigger\u003c/code\u003e for integrations not explicitly supported.",
+				Type:        "string",
+				Required:    true,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_FlagTriggersResourceCmd, client, OperationData{
@@ -1907,6 +2457,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/flags/{projectKey}/{featureFlagKey}/triggers/{environmentKey}/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_FlagTriggersResourceCmd, client, OperationData{
@@ -1944,6 +2496,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/flags/{projectKey}/{featureFlagKey}/triggers/{environmentKey}/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_FlagTriggersResourceCmd, client, OperationData{
@@ -1975,6 +2529,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/flags/{projectKey}/{featureFlagKey}/triggers/{environmentKey}",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_FlagTriggersResourceCmd, client, OperationData{
@@ -2012,6 +2568,16 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/flags/{projectKey}/{featureFlagKey}/triggers/{environmentKey}/{id}",
 		SupportsSemanticPatch: true,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "comment",
+				In:          "body",
+				Description: "Optional comment describing the update",
+				Type:        "string",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_FeatureFlagsResourceCmd, client, OperationData{
@@ -2037,6 +2603,30 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/flags/{projectKey}/{featureFlagKey}/copy",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "comment",
+				In:          "body",
+				Description: "Optional comment",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "excludedActions",
+				In:          "body",
+				Description: "Optional list of the flag changes NOT to copy from the source environment to the target environment. You may include either  \u003ccode\u003eincludedActions\u003c/code\u003e or \u003ccode\u003eexcludedActions\u003c/code\u003e, but not both. If you include neither, then all flag changes will be copied.",
+				Type:        "array",
+				Required:    false,
+			},
+			{
+				Name:        "includedActions",
+				In:          "body",
+				Description: "Optional list of the flag changes to copy from the source environment to the target environment. You may include either \u003ccode\u003eincludedActions\u003c/code\u003e or \u003ccode\u003eexcludedActions\u003c/code\u003e, but not both. If you include neither, then all flag changes will be copied.",
+				Type:        "array",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_FeatureFlagsResourceCmd, client, OperationData{
@@ -2062,6 +2652,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/flags/{projectKey}/{featureFlagKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_FeatureFlagsResourceCmd, client, OperationData{
@@ -2093,6 +2685,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/flags/{projectKey}/{featureFlagKey}/expiring-targets/{environmentKey}",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_FeatureFlagsResourceCmd, client, OperationData{
@@ -2124,6 +2718,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/flags/{projectKey}/{featureFlagKey}/expiring-user-targets/{environmentKey}",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_FeatureFlagsResourceCmd, client, OperationData{
@@ -2161,6 +2757,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/flags/{projectKey}/{featureFlagKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_FeatureFlagsResourceCmd, client, OperationData{
@@ -2192,6 +2790,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/flag-statuses/{projectKey}/{environmentKey}/{featureFlagKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_FeatureFlagsResourceCmd, client, OperationData{
@@ -2223,6 +2823,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/flag-status/{projectKey}/{featureFlagKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_FeatureFlagsResourceCmd, client, OperationData{
@@ -2248,6 +2850,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/flag-statuses/{projectKey}/{environmentKey}",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_FeatureFlagsResourceCmd, client, OperationData{
@@ -2321,6 +2925,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/flags/{projectKey}",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_FeatureFlagsResourceCmd, client, OperationData{
@@ -2352,6 +2958,16 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/flags/{projectKey}/{featureFlagKey}/expiring-targets/{environmentKey}",
 		SupportsSemanticPatch: true,
+		IsList:                true,
+		BodyParams: []Param{
+			{
+				Name:        "comment",
+				In:          "body",
+				Description: "Optional comment describing the change",
+				Type:        "string",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_FeatureFlagsResourceCmd, client, OperationData{
@@ -2383,6 +2999,16 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/flags/{projectKey}/{featureFlagKey}/expiring-user-targets/{environmentKey}",
 		SupportsSemanticPatch: true,
+		IsList:                true,
+		BodyParams: []Param{
+			{
+				Name:        "comment",
+				In:          "body",
+				Description: "Optional comment describing the change",
+				Type:        "string",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_FeatureFlagsResourceCmd, client, OperationData{
@@ -2408,6 +3034,16 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/flags/{projectKey}/{featureFlagKey}",
 		SupportsSemanticPatch: true,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "comment",
+				In:          "body",
+				Description: "Optional comment",
+				Type:        "string",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_FeatureFlagsResourceCmd, client, OperationData{
@@ -2433,6 +3069,65 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/flags/{projectKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "description",
+				In:          "body",
+				Description: "Description of the feature flag. Defaults to an empty string.",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "key",
+				In:          "body",
+				Description: "A unique key used to reference the flag in your code",
+				Type:        "string",
+				Required:    true,
+			},
+			{
+				Name:        "maintainerId",
+				In:          "body",
+				Description: "The ID of the member who maintains this feature flag",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "maintainerTeamKey",
+				In:          "body",
+				Description: "The key of the team that maintains this feature flag",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "name",
+				In:          "body",
+				Description: "A human-friendly name for the feature flag",
+				Type:        "string",
+				Required:    true,
+			},
+			{
+				Name:        "purpose",
+				In:          "body",
+				Description: "Purpose of the flag",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "tags",
+				In:          "body",
+				Description: "Tags for the feature flag. Defaults to an empty array.",
+				Type:        "array",
+				Required:    false,
+			},
+			{
+				Name:        "temporary",
+				In:          "body",
+				Description: "Whether the flag is a temporary flag. Defaults to \u003ccode\u003etrue\u003c/code\u003e.",
+				Type:        "boolean",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_FeatureFlagsResourceCmd, client, OperationData{
@@ -2464,6 +3159,16 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/projects/{projectKey}/flags/{flagKey}/environments/{environmentKey}/migration-safety-issues",
 		SupportsSemanticPatch: true,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "comment",
+				In:          "body",
+				Description: "",
+				Type:        "string",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_FollowFlagsResourceCmd, client, OperationData{
@@ -2501,6 +3206,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects/{projectKey}/flags/{featureFlagKey}/environments/{environmentKey}/followers/{memberId}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_FollowFlagsResourceCmd, client, OperationData{
@@ -2532,6 +3239,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects/{projectKey}/flags/{featureFlagKey}/environments/{environmentKey}/followers",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_FollowFlagsResourceCmd, client, OperationData{
@@ -2557,6 +3266,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects/{projectKey}/environments/{environmentKey}/followers",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_FollowFlagsResourceCmd, client, OperationData{
@@ -2594,6 +3305,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects/{projectKey}/flags/{featureFlagKey}/environments/{environmentKey}/followers/{memberId}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_IntegrationSubscriptionsResourceCmd, client, OperationData{
@@ -2613,6 +3326,44 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/integrations/{integrationKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "apiKey",
+				In:          "body",
+				Description: "Datadog API key. Only necessary for legacy Datadog webhook integrations.",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "name",
+				In:          "body",
+				Description: "A human-friendly name for your audit log subscription.",
+				Type:        "string",
+				Required:    true,
+			},
+			{
+				Name:        "on",
+				In:          "body",
+				Description: "Whether or not you want your subscription to actively send events.",
+				Type:        "boolean",
+				Required:    false,
+			},
+			{
+				Name:        "tags",
+				In:          "body",
+				Description: "An array of tags for this subscription.",
+				Type:        "array",
+				Required:    false,
+			},
+			{
+				Name:        "url",
+				In:          "body",
+				Description: "Slack webhook receiver URL. Only necessary for legacy Slack webhook integrations.",
+				Type:        "string",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_IntegrationSubscriptionsResourceCmd, client, OperationData{
@@ -2638,6 +3389,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/integrations/{integrationKey}/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_IntegrationSubscriptionsResourceCmd, client, OperationData{
@@ -2663,6 +3416,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/integrations/{integrationKey}/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_IntegrationSubscriptionsResourceCmd, client, OperationData{
@@ -2682,6 +3437,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/integrations/{integrationKey}",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_IntegrationSubscriptionsResourceCmd, client, OperationData{
@@ -2707,6 +3464,8 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/integrations/{integrationKey}/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_MembersResourceCmd, client, OperationData{
@@ -2726,6 +3485,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/members/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_MembersResourceCmd, client, OperationData{
@@ -2745,6 +3506,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/members/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_MembersResourceCmd, client, OperationData{
@@ -2782,6 +3545,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/members",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_MembersResourceCmd, client, OperationData{
@@ -2801,6 +3566,8 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/members/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_MembersResourceCmd, client, OperationData{
@@ -2820,6 +3587,16 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/members/{id}/teams",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "teamKeys",
+				In:          "body",
+				Description: "List of team keys",
+				Type:        "array",
+				Required:    true,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_MembersResourceCmd, client, OperationData{
@@ -2832,6 +3609,8 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/members",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_MetricsResourceCmd, client, OperationData{
@@ -2857,6 +3636,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/metrics/{projectKey}/{metricKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_MetricsResourceCmd, client, OperationData{
@@ -2894,6 +3675,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/metrics/{projectKey}/{metricKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_MetricsResourceCmd, client, OperationData{
@@ -2919,6 +3702,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/metrics/{projectKey}",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_MetricsResourceCmd, client, OperationData{
@@ -2944,6 +3729,8 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/metrics/{projectKey}/{metricKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_MetricsResourceCmd, client, OperationData{
@@ -2963,6 +3750,114 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/metrics/{projectKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "analysisType",
+				In:          "body",
+				Description: "The method for analyzing metric events",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "description",
+				In:          "body",
+				Description: "Description of the metric",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "eventKey",
+				In:          "body",
+				Description: "The event key to use in your code. Required for custom conversion/binary and custom numeric metrics only.",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "isActive",
+				In:          "body",
+				Description: "Whether the metric is active. Set to \u003ccode\u003etrue\u003c/code\u003e to record click or pageview metrics. Not applicable for custom metrics.",
+				Type:        "boolean",
+				Required:    false,
+			},
+			{
+				Name:        "isNumeric",
+				In:          "body",
+				Description: "Whether to track numeric changes in value against a baseline (\u003ccode\u003etrue\u003c/code\u003e) or to track a conversion when an end user takes an action (\u003ccode\u003efalse\u003c/code\u003e). Required for custom metrics only.",
+				Type:        "boolean",
+				Required:    false,
+			},
+			{
+				Name:        "key",
+				In:          "body",
+				Description: "A unique key to reference the metric",
+				Type:        "string",
+				Required:    true,
+			},
+			{
+				Name:        "kind",
+				In:          "body",
+				Description: "The kind of event your metric will track",
+				Type:        "string",
+				Required:    true,
+			},
+			{
+				Name:        "name",
+				In:          "body",
+				Description: "A human-friendly name for the metric",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "percentileValue",
+				In:          "body",
+				Description: "The percentile for the analysis method. An integer denoting the target percentile between 0 and 100. Required when \u003ccode\u003eanalysisType\u003c/code\u003e is \u003ccode\u003epercentile\u003c/code\u003e.",
+				Type:        "integer",
+				Required:    false,
+			},
+			{
+				Name:        "randomizationUnits",
+				In:          "body",
+				Description: "An array of randomization units allowed for this metric",
+				Type:        "array",
+				Required:    false,
+			},
+			{
+				Name:        "selector",
+				In:          "body",
+				Description: "One or more CSS selectors. Required for click metrics only.",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "successCriteria",
+				In:          "body",
+				Description: "Success criteria. Required for custom numeric metrics, optional for custom conversion metrics.",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "tags",
+				In:          "body",
+				Description: "Tags for the metric",
+				Type:        "array",
+				Required:    false,
+			},
+			{
+				Name:        "unit",
+				In:          "body",
+				Description: "The unit of measure. Applicable for numeric custom metrics only.",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "unitAggregationType",
+				In:          "body",
+				Description: "The method by which multiple unit event values are aggregated",
+				Type:        "string",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_ProjectsResourceCmd, client, OperationData{
@@ -2982,6 +3877,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects/{projectKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_ProjectsResourceCmd, client, OperationData{
@@ -3001,6 +3898,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects/{projectKey}/flag-defaults",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_ProjectsResourceCmd, client, OperationData{
@@ -3026,6 +3925,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects/{projectKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_ProjectsResourceCmd, client, OperationData{
@@ -3069,6 +3970,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_ProjectsResourceCmd, client, OperationData{
@@ -3088,6 +3991,8 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/projects/{projectKey}/flag-defaults",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_ProjectsResourceCmd, client, OperationData{
@@ -3107,6 +4012,8 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/projects/{projectKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_ProjectsResourceCmd, client, OperationData{
@@ -3119,6 +4026,37 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/projects",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "includeInSnippetByDefault",
+				In:          "body",
+				Description: "Whether or not flags created in this project are made available to the client-side JavaScript SDK by default.",
+				Type:        "boolean",
+				Required:    false,
+			},
+			{
+				Name:        "key",
+				In:          "body",
+				Description: "A unique key used to reference the project in your code.",
+				Type:        "string",
+				Required:    true,
+			},
+			{
+				Name:        "name",
+				In:          "body",
+				Description: "A human-friendly name for the project.",
+				Type:        "string",
+				Required:    true,
+			},
+			{
+				Name:        "tags",
+				In:          "body",
+				Description: "Tags for the project",
+				Type:        "array",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_ProjectsResourceCmd, client, OperationData{
@@ -3138,6 +4076,23 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/projects/{projectKey}/flag-defaults",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "tags",
+				In:          "body",
+				Description: "A list of default tags for each flag",
+				Type:        "array",
+				Required:    true,
+			},
+			{
+				Name:        "temporary",
+				In:          "body",
+				Description: "Whether the flag should be temporary by default",
+				Type:        "boolean",
+				Required:    true,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_RelayProxyConfigsResourceCmd, client, OperationData{
@@ -3157,6 +4112,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/account/relay-auto-configs/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_RelayProxyConfigsResourceCmd, client, OperationData{
@@ -3176,6 +4133,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/account/relay-auto-configs/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_RelayProxyConfigsResourceCmd, client, OperationData{
@@ -3188,6 +4147,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/account/relay-auto-configs",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_RelayProxyConfigsResourceCmd, client, OperationData{
@@ -3207,6 +4168,16 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/account/relay-auto-configs/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "comment",
+				In:          "body",
+				Description: "Optional comment",
+				Type:        "string",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_RelayProxyConfigsResourceCmd, client, OperationData{
@@ -3219,6 +4190,16 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/account/relay-auto-configs",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "name",
+				In:          "body",
+				Description: "A human-friendly name for the Relay Proxy configuration",
+				Type:        "string",
+				Required:    true,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_RelayProxyConfigsResourceCmd, client, OperationData{
@@ -3244,6 +4225,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/account/relay-auto-configs/{id}/reset",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_ScheduledChangesResourceCmd, client, OperationData{
@@ -3281,6 +4264,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects/{projectKey}/flags/{featureFlagKey}/environments/{environmentKey}/scheduled-changes/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_ScheduledChangesResourceCmd, client, OperationData{
@@ -3318,6 +4303,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects/{projectKey}/flags/{featureFlagKey}/environments/{environmentKey}/scheduled-changes/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_ScheduledChangesResourceCmd, client, OperationData{
@@ -3349,6 +4336,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects/{projectKey}/flags/{featureFlagKey}/environments/{environmentKey}/scheduled-changes",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_ScheduledChangesResourceCmd, client, OperationData{
@@ -3392,6 +4381,16 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/projects/{projectKey}/flags/{featureFlagKey}/environments/{environmentKey}/scheduled-changes/{id}",
 		SupportsSemanticPatch: true,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "comment",
+				In:          "body",
+				Description: "Optional comment describing the update to the scheduled changes",
+				Type:        "string",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_ScheduledChangesResourceCmd, client, OperationData{
@@ -3429,6 +4428,23 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/projects/{projectKey}/flags/{featureFlagKey}/environments/{environmentKey}/scheduled-changes",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "comment",
+				In:          "body",
+				Description: "Optional comment describing the scheduled changes",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "executionDate",
+				In:          "body",
+				Description: "",
+				Type:        "integer",
+				Required:    true,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_SegmentsResourceCmd, client, OperationData{
@@ -3460,6 +4476,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/segments/{projectKey}/{environmentKey}/{segmentKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_SegmentsResourceCmd, client, OperationData{
@@ -3485,6 +4503,8 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/projects/{projectKey}/environments/{environmentKey}/segments/evaluate",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_SegmentsResourceCmd, client, OperationData{
@@ -3516,6 +4536,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/segments/{projectKey}/{segmentKey}/expiring-targets/{environmentKey}",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_SegmentsResourceCmd, client, OperationData{
@@ -3547,6 +4569,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/segments/{projectKey}/{segmentKey}/expiring-user-targets/{environmentKey}",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_SegmentsResourceCmd, client, OperationData{
@@ -3578,6 +4602,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/segments/{projectKey}/{environmentKey}/{segmentKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_SegmentsResourceCmd, client, OperationData{
@@ -3615,6 +4641,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/segments/{projectKey}/{environmentKey}/{segmentKey}/contexts/{contextKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_SegmentsResourceCmd, client, OperationData{
@@ -3652,6 +4680,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/segments/{projectKey}/{environmentKey}/{segmentKey}/users/{userKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_SegmentsResourceCmd, client, OperationData{
@@ -3701,6 +4731,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/segments/{projectKey}/{environmentKey}",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_SegmentsResourceCmd, client, OperationData{
@@ -3732,6 +4764,16 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/segments/{projectKey}/{segmentKey}/expiring-targets/{environmentKey}",
 		SupportsSemanticPatch: true,
+		IsList:                true,
+		BodyParams: []Param{
+			{
+				Name:        "comment",
+				In:          "body",
+				Description: "Optional description of changes",
+				Type:        "string",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_SegmentsResourceCmd, client, OperationData{
@@ -3763,6 +4805,16 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/segments/{projectKey}/{segmentKey}/expiring-user-targets/{environmentKey}",
 		SupportsSemanticPatch: true,
+		IsList:                true,
+		BodyParams: []Param{
+			{
+				Name:        "comment",
+				In:          "body",
+				Description: "Optional description of changes",
+				Type:        "string",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_SegmentsResourceCmd, client, OperationData{
@@ -3794,6 +4846,16 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/segments/{projectKey}/{environmentKey}/{segmentKey}",
 		SupportsSemanticPatch: true,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "comment",
+				In:          "body",
+				Description: "Optional comment",
+				Type:        "string",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_SegmentsResourceCmd, client, OperationData{
@@ -3819,6 +4881,51 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/segments/{projectKey}/{environmentKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "description",
+				In:          "body",
+				Description: "A description of the segment's purpose",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "key",
+				In:          "body",
+				Description: "A unique key used to reference the segment",
+				Type:        "string",
+				Required:    true,
+			},
+			{
+				Name:        "name",
+				In:          "body",
+				Description: "A human-friendly name for the segment",
+				Type:        "string",
+				Required:    true,
+			},
+			{
+				Name:        "tags",
+				In:          "body",
+				Description: "Tags for the segment",
+				Type:        "array",
+				Required:    false,
+			},
+			{
+				Name:        "unbounded",
+				In:          "body",
+				Description: "Whether to create a standard segment (\u003ccode\u003efalse\u003c/code\u003e) or a big segment (\u003ccode\u003etrue\u003c/code\u003e). Standard segments include rule-based and smaller list-based segments. Big segments include larger list-based segments and synced segments. Only use a big segment if you need to add more than 15,000 individual targets.",
+				Type:        "boolean",
+				Required:    false,
+			},
+			{
+				Name:        "unboundedContextKind",
+				In:          "body",
+				Description: "For big segments, the targeted context kind.",
+				Type:        "string",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_SegmentsResourceCmd, client, OperationData{
@@ -3850,6 +4957,8 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/segments/{projectKey}/{environmentKey}/{segmentKey}/contexts",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_SegmentsResourceCmd, client, OperationData{
@@ -3881,6 +4990,8 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/segments/{projectKey}/{environmentKey}/{segmentKey}/users",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_TagsResourceCmd, client, OperationData{
@@ -3912,6 +5023,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/tags",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_TeamsResourceCmd, client, OperationData{
@@ -3931,6 +5044,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/teams/{teamKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_TeamsResourceCmd, client, OperationData{
@@ -3956,6 +5071,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/teams/{teamKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_TeamsResourceCmd, client, OperationData{
@@ -3987,6 +5104,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/teams/{teamKey}/maintainers",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_TeamsResourceCmd, client, OperationData{
@@ -4018,6 +5137,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/teams/{teamKey}/roles",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_TeamsResourceCmd, client, OperationData{
@@ -4055,6 +5176,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/teams",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_TeamsResourceCmd, client, OperationData{
@@ -4080,6 +5203,16 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/teams/{teamKey}",
 		SupportsSemanticPatch: true,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "comment",
+				In:          "body",
+				Description: "Optional comment describing the update",
+				Type:        "string",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_TeamsResourceCmd, client, OperationData{
@@ -4099,6 +5232,44 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/teams",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "customRoleKeys",
+				In:          "body",
+				Description: "List of custom role keys the team will access",
+				Type:        "array",
+				Required:    false,
+			},
+			{
+				Name:        "description",
+				In:          "body",
+				Description: "A description of the team",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "key",
+				In:          "body",
+				Description: "The team key",
+				Type:        "string",
+				Required:    true,
+			},
+			{
+				Name:        "memberIDs",
+				In:          "body",
+				Description: "A list of member IDs who belong to the team",
+				Type:        "array",
+				Required:    false,
+			},
+			{
+				Name:        "name",
+				In:          "body",
+				Description: "A human-friendly name for the team",
+				Type:        "string",
+				Required:    true,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_TeamsResourceCmd, client, OperationData{
@@ -4118,6 +5289,8 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/teams/{teamKey}/members",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_TokensResourceCmd, client, OperationData{
@@ -4137,6 +5310,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/tokens/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_TokensResourceCmd, client, OperationData{
@@ -4156,6 +5331,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/tokens/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_TokensResourceCmd, client, OperationData{
@@ -4187,6 +5364,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/tokens",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_TokensResourceCmd, client, OperationData{
@@ -4206,6 +5385,8 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/tokens/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_TokensResourceCmd, client, OperationData{
@@ -4218,6 +5399,51 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/tokens",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "customRoleIds",
+				In:          "body",
+				Description: "A list of custom role IDs to use as access limits for the access token",
+				Type:        "array",
+				Required:    false,
+			},
+			{
+				Name:        "defaultApiVersion",
+				In:          "body",
+				Description: "The default API version for this token",
+				Type:        "integer",
+				Required:    false,
+			},
+			{
+				Name:        "description",
+				In:          "body",
+				Description: "A description for the access token",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "name",
+				In:          "body",
+				Description: "A human-friendly name for the access token",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "role",
+				In:          "body",
+				Description: "Built-in role for the token",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "serviceToken",
+				In:          "body",
+				Description: "Whether the token is a service token https://docs.launchdarkly.com/home/account-security/api-access-tokens#service-tokens",
+				Type:        "boolean",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_TokensResourceCmd, client, OperationData{
@@ -4243,6 +5469,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/tokens/{id}/reset",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_UserFlagSettingsResourceCmd, client, OperationData{
@@ -4274,6 +5502,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/users/{projectKey}/{userKey}/expiring-user-targets/{environmentKey}",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_UserFlagSettingsResourceCmd, client, OperationData{
@@ -4311,6 +5541,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/users/{projectKey}/{environmentKey}/{userKey}/flags/{featureFlagKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_UserFlagSettingsResourceCmd, client, OperationData{
@@ -4342,6 +5574,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/users/{projectKey}/{environmentKey}/{userKey}/flags",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_UserFlagSettingsResourceCmd, client, OperationData{
@@ -4373,6 +5607,16 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/users/{projectKey}/{userKey}/expiring-user-targets/{environmentKey}",
 		SupportsSemanticPatch: true,
+		IsList:                true,
+		BodyParams: []Param{
+			{
+				Name:        "comment",
+				In:          "body",
+				Description: "Optional comment describing the change",
+				Type:        "string",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_UserFlagSettingsResourceCmd, client, OperationData{
@@ -4410,6 +5654,16 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/users/{projectKey}/{environmentKey}/{userKey}/flags/{featureFlagKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "comment",
+				In:          "body",
+				Description: "Optional comment describing the change",
+				Type:        "string",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_UsersResourceCmd, client, OperationData{
@@ -4441,6 +5695,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/users/{projectKey}/{environmentKey}/{userKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_UsersResourceCmd, client, OperationData{
@@ -4502,6 +5758,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/user-search/{projectKey}/{environmentKey}",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_UsersResourceCmd, client, OperationData{
@@ -4533,6 +5791,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/users/{projectKey}/{environmentKey}/{userKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_UsersResourceCmd, client, OperationData{
@@ -4570,6 +5830,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/users/{projectKey}/{environmentKey}",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_WebhooksResourceCmd, client, OperationData{
@@ -4589,6 +5851,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/webhooks/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_WebhooksResourceCmd, client, OperationData{
@@ -4601,6 +5865,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/webhooks",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_WebhooksResourceCmd, client, OperationData{
@@ -4620,6 +5886,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/webhooks/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_WebhooksResourceCmd, client, OperationData{
@@ -4639,6 +5907,8 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/webhooks/{id}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_WebhooksResourceCmd, client, OperationData{
@@ -4651,6 +5921,51 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/webhooks",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "name",
+				In:          "body",
+				Description: "A human-readable name for your webhook",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "on",
+				In:          "body",
+				Description: "Whether or not this webhook is enabled.",
+				Type:        "boolean",
+				Required:    true,
+			},
+			{
+				Name:        "secret",
+				In:          "body",
+				Description: "If sign is true, and the secret attribute is omitted, LaunchDarkly automatically generates a secret for you.",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "sign",
+				In:          "body",
+				Description: "If sign is false, the webhook does not include a signature header, and the secret can be omitted.",
+				Type:        "boolean",
+				Required:    true,
+			},
+			{
+				Name:        "tags",
+				In:          "body",
+				Description: "List of tags for this webhook",
+				Type:        "array",
+				Required:    false,
+			},
+			{
+				Name:        "url",
+				In:          "body",
+				Description: "The URL of the remote webhook",
+				Type:        "string",
+				Required:    true,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_WorkflowTemplatesResourceCmd, client, OperationData{
@@ -4663,6 +5978,58 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/templates",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "description",
+				In:          "body",
+				Description: "",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "environmentKey",
+				In:          "body",
+				Description: "",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "flagKey",
+				In:          "body",
+				Description: "",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "key",
+				In:          "body",
+				Description: "",
+				Type:        "string",
+				Required:    true,
+			},
+			{
+				Name:        "name",
+				In:          "body",
+				Description: "",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "projectKey",
+				In:          "body",
+				Description: "",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "workflowId",
+				In:          "body",
+				Description: "",
+				Type:        "string",
+				Required:    false,
+			},
+		},
 	})
 
 	NewOperationCmd(gen_WorkflowTemplatesResourceCmd, client, OperationData{
@@ -4682,6 +6049,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/templates/{templateKey}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_WorkflowTemplatesResourceCmd, client, OperationData{
@@ -4707,6 +6076,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/templates",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_WorkflowsResourceCmd, client, OperationData{
@@ -4744,6 +6115,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects/{projectKey}/flags/{featureFlagKey}/environments/{environmentKey}/workflows/{workflowId}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_WorkflowsResourceCmd, client, OperationData{
@@ -4781,6 +6154,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects/{projectKey}/flags/{featureFlagKey}/environments/{environmentKey}/workflows/{workflowId}",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_WorkflowsResourceCmd, client, OperationData{
@@ -4836,6 +6211,8 @@ func AddAllResourceCmds(
 		RequiresBody:          false,
 		Path:                  "/api/v2/projects/{projectKey}/flags/{featureFlagKey}/environments/{environmentKey}/workflows",
 		SupportsSemanticPatch: false,
+		IsList:                true,
+		BodyParams:            []Param{},
 	})
 
 	NewOperationCmd(gen_WorkflowsResourceCmd, client, OperationData{
@@ -4879,6 +6256,37 @@ func AddAllResourceCmds(
 		RequiresBody:          true,
 		Path:                  "/api/v2/projects/{projectKey}/flags/{featureFlagKey}/environments/{environmentKey}/workflows",
 		SupportsSemanticPatch: false,
+		IsList:                false,
+		BodyParams: []Param{
+			{
+				Name:        "description",
+				In:          "body",
+				Description: "The workflow description",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "maintainerId",
+				In:          "body",
+				Description: "",
+				Type:        "string",
+				Required:    false,
+			},
+			{
+				Name:        "name",
+				In:          "body",
+				Description: "The workflow name",
+				Type:        "string",
+				Required:    true,
+			},
+			{
+				Name:        "templateKey",
+				In:          "body",
+				Description: "The template key",
+				Type:        "string",
+				Required:    false,
+			},
+		},
 	})
 
 }
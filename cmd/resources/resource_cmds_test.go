@@ -8,6 +8,7 @@ import (
 
 	"ldcli/cmd"
 	"ldcli/internal/analytics"
+	"ldcli/internal/resources"
 )
 
 func TestCreateTeam(t *testing.T) {
@@ -50,4 +51,75 @@ func TestCreateTeam(t *testing.T) {
 		s := string(output)
 		assert.Contains(t, s, "would be making a post request to /api/v2/teams here, with args: map[data:map[key:team-key name:Team Name] expand:]\n")
 	})
+	t.Run("with dry run prints the request without sending it", func(t *testing.T) {
+		mockClient := &resources.MockClient{}
+		args := []string{
+			"teams",
+			"create",
+			"--access-token",
+			"abcd1234",
+			"--base-uri",
+			"http://test.com",
+			"--data",
+			`{"key": "team-key", "name": "Team Name"}`,
+			"--dry-run",
+		}
+
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: mockClient,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.Nil(t, mockClient.Input)
+		assert.Equal(t, `POST http://test.com/api/v2/teams
+Authorization: [REDACTED]
+Content-Type: application/json
+User-Agent: launchdarkly-cli/vtest
+
+{
+  "key": "team-key",
+  "name": "Team Name"
+}
+`, string(output))
+	})
+	t.Run("with print curl prints a curl command without sending the request", func(t *testing.T) {
+		mockClient := &resources.MockClient{}
+		args := []string{
+			"teams",
+			"create",
+			"--access-token",
+			"abcd1234",
+			"--base-uri",
+			"http://test.com",
+			"--data",
+			`{"key": "team-key", "name": "Team's Name"}`,
+			"--print-curl",
+		}
+
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: mockClient,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.Nil(t, mockClient.Input)
+		assert.Equal(
+			t,
+			`curl -X POST 'http://test.com/api/v2/teams' `+
+				`-H "Authorization: $LD_ACCESS_TOKEN" `+
+				`-H 'Content-Type: application/json' `+
+				`-H 'User-Agent: launchdarkly-cli/vtest' `+
+				`--data-raw '{"key":"team-key","name":"Team'\''s Name"}'`+"\n",
+			string(output),
+		)
+	})
 }
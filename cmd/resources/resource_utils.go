@@ -3,11 +3,14 @@ package resources
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/iancoleman/strcase"
+
+	"ldcli/cmd/cliflags"
 )
 
 // we have certain tags that aren't a 1:1 match to their operation id names
@@ -150,6 +153,8 @@ func isListResponse(op *openapi3.Operation, spec *openapi3.T) bool {
 }
 
 var mapParamToFlagName = map[string]string{
+	// the global dry run flag prints the request instead of sending it
+	"dry-run":      "server-dry-run",
 	"feature-flag": "flag",
 }
 
@@ -170,3 +175,119 @@ func getFlagName(paramName string) string {
 	}
 	return flagName
 }
+
+// reservedFlagNames are flags that operation commands already have, so request body properties
+// with the same name don't get their own flag.
+var reservedFlagNames = []string{
+	cliflags.AccessTokenFlag,
+	cliflags.AllFlag,
+	cliflags.AnalyticsOptOut,
+	cliflags.BaseURIFlag,
+	cliflags.ColumnsFlag,
+	cliflags.DataFlag,
+	cliflags.DryRunFlag,
+	cliflags.MaxRetriesFlag,
+	cliflags.OutputFlag,
+	cliflags.PrintCurlFlag,
+	cliflags.ProfileFlag,
+	cliflags.QueryFlag,
+	cliflags.SkipValidationFlag,
+	cliflags.TimeoutFlag,
+	cliflags.VerboseFlag,
+	"help",
+	"semantic-patch",
+}
+
+// getBodyParams builds params for the top-level properties of a JSON request body that can be set
+// from a single flag, which are scalar values and lists of strings.
+func getBodyParams(requestBody *openapi3.RequestBody, params []Param) []Param {
+	mediaType := requestBody.Content.Get("application/json")
+	if mediaType == nil || mediaType.Schema == nil || mediaType.Schema.Value == nil {
+		return nil
+	}
+	schema := mediaType.Schema.Value
+	if schema.Type == nil || !schema.Type.Is("object") {
+		return nil
+	}
+
+	usedFlagNames := make(map[string]struct{})
+	for _, name := range reservedFlagNames {
+		usedFlagNames[name] = struct{}{}
+	}
+	for _, p := range params {
+		usedFlagNames[getFlagName(p.Name)] = struct{}{}
+	}
+
+	required := make(map[string]bool)
+	for _, name := range schema.Required {
+		required[name] = true
+	}
+
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	bodyParams := make([]Param, 0)
+	for _, name := range names {
+		prop := schema.Properties[name].Value
+		if prop == nil || strings.Contains(prop.Description, "Deprecated") {
+			continue
+		}
+		paramType := getBodyParamType(prop)
+		if paramType == "" {
+			continue
+		}
+		flagName := getBodyFlagName(name)
+		if _, ok := usedFlagNames[flagName]; ok {
+			continue
+		}
+		usedFlagNames[flagName] = struct{}{}
+
+		bodyParams = append(bodyParams, Param{
+			Name:        name,
+			In:          "body",
+			Description: jsonString(prop.Description),
+			Type:        paramType,
+			Required:    required[name],
+		})
+	}
+
+	return bodyParams
+}
+
+// getBodyParamType returns the type of a property if it can be set from a flag, or an empty string
+// if it can't.
+func getBodyParamType(schema *openapi3.Schema) string {
+	if schema.Type == nil {
+		return ""
+	}
+
+	for _, t := range []string{"boolean", "integer", "number", "string"} {
+		if schema.Type.Is(t) {
+			return t
+		}
+	}
+	if schema.Type.Is("array") &&
+		schema.Items != nil &&
+		schema.Items.Value != nil &&
+		schema.Items.Value.Type != nil &&
+		schema.Items.Value.Type.Is("string") {
+		return "array"
+	}
+
+	return ""
+}
+
+// pluralAcronym matches a plural acronym such as "IDs" so it can be converted to "ids" instead of
+// "i-ds".
+var pluralAcronym = regexp.MustCompile(`([A-Z]{2,})s\b`)
+
+func getBodyFlagName(propertyName string) string {
+	name := pluralAcronym.ReplaceAllStringFunc(propertyName, func(match string) string {
+		return match[:1] + strings.ToLower(match[1:])
+	})
+
+	return strcase.ToKebab(name)
+}
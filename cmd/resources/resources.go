@@ -64,6 +64,8 @@ type OperationData struct {
 	RequiresBody          bool
 	Path                  string
 	SupportsSemanticPatch bool
+	IsList                bool
+	BodyParams            []Param
 }
 
 type Param struct {
@@ -129,6 +131,7 @@ func GetTemplateData(fileName string) (TemplateData, error) {
 				RequiresBody:          requiresBody,
 				Path:                  path,
 				SupportsSemanticPatch: supportsSemanticPatch,
+				IsList:                isList,
 			}
 
 			for _, p := range op.Parameters {
@@ -149,6 +152,10 @@ func GetTemplateData(fileName string) (TemplateData, error) {
 				}
 			}
 
+			if op.RequestBody != nil {
+				operation.BodyParams = getBodyParams(op.RequestBody.Value, operation.Params)
+			}
+
 			resource.Operations[op.OperationID] = operation
 		}
 	}
@@ -156,6 +163,92 @@ func GetTemplateData(fileName string) (TemplateData, error) {
 	return TemplateData{Resources: resources}, nil
 }
 
+// GetRequestSchemas builds an OpenAPI document with only the request bodies of each operation and
+// the schemas they use, so commands can validate their input without the full spec.
+func GetRequestSchemas(fileName string) ([]byte, error) {
+	rawFile, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	loader := openapi3.NewLoader()
+	spec, err := loader.LoadFromData(rawFile)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := openapi3.NewPaths()
+	schemaNames := make([]string, 0)
+	for path, pathItem := range spec.Paths.Map() {
+		item := &openapi3.PathItem{}
+		for method, op := range pathItem.Operations() {
+			if op.RequestBody == nil || op.RequestBody.Value.Content.Get("application/json") == nil {
+				continue
+			}
+			item.SetOperation(method, &openapi3.Operation{
+				RequestBody: op.RequestBody,
+				Responses:   openapi3.NewResponses(),
+			})
+
+			names, err := schemaRefNames(op.RequestBody)
+			if err != nil {
+				return nil, err
+			}
+			schemaNames = append(schemaNames, names...)
+		}
+		if len(item.Operations()) > 0 {
+			paths.Set(path, item)
+		}
+	}
+
+	// only keep the schemas the request bodies use
+	schemas := make(openapi3.Schemas)
+	for len(schemaNames) > 0 {
+		name := schemaNames[0]
+		schemaNames = schemaNames[1:]
+		if _, ok := schemas[name]; ok {
+			continue
+		}
+		schema, ok := spec.Components.Schemas[name]
+		if !ok {
+			return nil, fmt.Errorf("schema not found: %s", name)
+		}
+		schemas[name] = schema
+
+		names, err := schemaRefNames(schema.Value)
+		if err != nil {
+			return nil, err
+		}
+		schemaNames = append(schemaNames, names...)
+	}
+
+	return json.MarshalIndent(openapi3.T{
+		OpenAPI: spec.OpenAPI,
+		Info:    spec.Info,
+		Paths:   paths,
+		Components: &openapi3.Components{
+			Schemas: schemas,
+		},
+	}, "", "  ")
+}
+
+var schemaRefPattern = regexp.MustCompile(`"#/components/schemas/([^"]+)"`)
+
+// schemaRefNames gets the names of the component schemas the value refers to.
+func schemaRefNames(v interface{}) ([]string, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0)
+	for _, match := range schemaRefPattern.FindAllStringSubmatch(string(raw), -1) {
+		names = append(names, match[1])
+	}
+
+	return names, nil
+}
+
 func NewResourceData(tag openapi3.Tag) ResourceData {
 	resourceName, _ := getResourceNames(tag.Name)
 
@@ -225,8 +318,14 @@ type OperationCmd struct {
 
 func (op *OperationCmd) initFlags() error {
 	if op.HasBody {
-		op.cmd.Flags().StringP(cliflags.DataFlag, "d", "", "Input data in JSON")
-		if op.RequiresBody {
+		op.cmd.Flags().StringP(
+			cliflags.DataFlag,
+			"d",
+			"",
+			"Input data in JSON or YAML. Use @ followed by a file path to read from a file, or - to read from stdin",
+		)
+		// the body can come from either the data flag or the body flags
+		if op.RequiresBody && len(op.BodyParams) == 0 {
 			err := op.cmd.MarkFlagRequired(cliflags.DataFlag)
 			if err != nil {
 				return err
@@ -236,6 +335,12 @@ func (op *OperationCmd) initFlags() error {
 		if err != nil {
 			return err
 		}
+
+		op.cmd.Flags().Bool(cliflags.SkipValidationFlag, false, "Send the input data without validating it first")
+		err = viper.BindPFlag(cliflags.SkipValidationFlag, op.cmd.Flags().Lookup(cliflags.SkipValidationFlag))
+		if err != nil {
+			return err
+		}
 	}
 
 	if op.SupportsSemanticPatch {
@@ -246,6 +351,14 @@ func (op *OperationCmd) initFlags() error {
 		}
 	}
 
+	if op.IsList {
+		op.cmd.Flags().Bool(cliflags.AllFlag, false, "Fetch every page of results")
+		err := viper.BindPFlag(cliflags.AllFlag, op.cmd.Flags().Lookup(cliflags.AllFlag))
+		if err != nil {
+			return err
+		}
+	}
+
 	for _, p := range op.Params {
 		flagName := getFlagName(p.Name)
 
@@ -264,9 +377,79 @@ func (op *OperationCmd) initFlags() error {
 			return err
 		}
 	}
+
+	for _, p := range op.BodyParams {
+		flagName := getBodyFlagName(p.Name)
+
+		switch p.Type {
+		case "array":
+			op.cmd.Flags().StringSlice(flagName, []string{}, p.Description)
+		case "boolean":
+			op.cmd.Flags().Bool(flagName, false, p.Description)
+		case "integer":
+			op.cmd.Flags().Int(flagName, 0, p.Description)
+		case "number":
+			op.cmd.Flags().Float64(flagName, 0, p.Description)
+		default:
+			op.cmd.Flags().String(flagName, "", p.Description)
+		}
+
+		if p.Required {
+			// a required property can also be set with the data flag
+			op.cmd.MarkFlagsOneRequired(flagName, cliflags.DataFlag)
+			_ = op.cmd.Flags().SetAnnotation(flagName, "required", []string{"true"})
+		}
+
+		err := viper.BindPFlag(flagName, op.cmd.Flags().Lookup(flagName))
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// addBodyFlagValues sets the values of any body flags the user provided on the request body. These
+// values replace the same fields from the data flag.
+func (op *OperationCmd) addBodyFlagValues(data interface{}) (interface{}, error) {
+	values := make(map[string]interface{})
+	for _, p := range op.BodyParams {
+		flagName := getBodyFlagName(p.Name)
+		if !op.cmd.Flags().Changed(flagName) {
+			continue
+		}
+
+		switch p.Type {
+		case "array":
+			values[p.Name] = viper.GetStringSlice(flagName)
+		case "boolean":
+			values[p.Name] = viper.GetBool(flagName)
+		case "integer":
+			values[p.Name] = viper.GetInt(flagName)
+		case "number":
+			values[p.Name] = viper.GetFloat64(flagName)
+		default:
+			values[p.Name] = viper.GetString(flagName)
+		}
+	}
+	if len(values) == 0 {
+		return data, nil
+	}
+
+	if data == nil {
+		data = make(map[string]interface{})
+	}
+	body, ok := data.(map[string]interface{})
+	if !ok {
+		return nil, errors.NewError("data must be a JSON object when used with other body flags")
+	}
+	for k, v := range values {
+		body[k] = v
+	}
+
+	return body, nil
+}
+
 func buildURLWithParams(baseURI, path string, urlParams []string) string {
 	s := make([]interface{}, len(urlParams))
 	for i, v := range urlParams {
@@ -279,18 +462,57 @@ func buildURLWithParams(baseURI, path string, urlParams []string) string {
 	return baseURI + fmt.Sprintf(format, s...)
 }
 
+// NewRequestClient returns a client that prints the request instead of sending it if the user only
+// wants to see the request, along with whether the request will only be printed. Otherwise it
+// returns the client with the retry and timeout settings from the flags.
+func NewRequestClient(cmd *cobra.Command, client resources.Client) (resources.Client, bool) {
+	switch {
+	case viper.GetBool(cliflags.PrintCurlFlag):
+		return resources.NewCurlClient(cmd.Root().Version, cmd.OutOrStdout()), true
+	case viper.GetBool(cliflags.DryRunFlag):
+		return resources.NewDryRunClient(cmd.Root().Version, cmd.OutOrStdout()), true
+	default:
+		return WithRequestOptions(client), false
+	}
+}
+
+// WithRequestOptions gets the client with the retry and timeout settings. Commands that read a
+// resource before changing it use it to make the read even when they only print the change.
+func WithRequestOptions(client resources.Client) resources.Client {
+	if c, ok := client.(resources.ResourcesClient); ok {
+		return c.WithOptions(resources.RequestOptions{
+			MaxRetries: viper.GetInt(cliflags.MaxRetriesFlag),
+			Timeout:    viper.GetDuration(cliflags.TimeoutFlag),
+		})
+	}
+
+	return client
+}
+
 func (op *OperationCmd) makeRequest(cmd *cobra.Command, args []string) error {
 	var data interface{}
-	if op.RequiresBody {
-		err := json.Unmarshal([]byte(viper.GetString(cliflags.DataFlag)), &data)
+	if op.HasBody && viper.GetString(cliflags.DataFlag) != "" {
+		var err error
+		data, err = ReadData(viper.GetString(cliflags.DataFlag), cmd.InOrStdin())
 		if err != nil {
 			return err
 		}
 	}
+	data, err := op.addBodyFlagValues(data)
+	if err != nil {
+		return err
+	}
 	jsonData, err := json.Marshal(data)
 	if err != nil {
 		return err
 	}
+	// semantic patch instructions don't match the request body's schema
+	if op.HasBody && !viper.GetBool(cliflags.SkipValidationFlag) && !viper.GetBool("semantic-patch") {
+		err = validateData(op.HTTPMethod, op.Path, jsonData)
+		if err != nil {
+			return err
+		}
+	}
 
 	query := url.Values{}
 	var urlParms []string
@@ -314,7 +536,19 @@ func (op *OperationCmd) makeRequest(cmd *cobra.Command, args []string) error {
 		contentType += "; domain-model=launchdarkly.semanticpatch"
 	}
 
-	res, err := op.client.MakeRequest(
+	client, printOnly := NewRequestClient(cmd, op.client)
+	makeRequestFn := client.MakeRequest
+	if op.IsList && viper.GetBool(cliflags.AllFlag) && !printOnly {
+		makeRequestFn = func(
+			accessToken, method, path, contentType string,
+			query url.Values,
+			data []byte,
+		) ([]byte, error) {
+			return makePaginatedRequest(client, accessToken, method, path, contentType, query, data)
+		}
+	}
+
+	res, err := makeRequestFn(
 		viper.GetString(cliflags.AccessTokenFlag),
 		strings.ToUpper(op.HTTPMethod),
 		path,
@@ -323,7 +557,10 @@ func (op *OperationCmd) makeRequest(cmd *cobra.Command, args []string) error {
 		jsonData,
 	)
 	if err != nil {
-		return errors.NewError(output.CmdOutputError(viper.GetString(cliflags.OutputFlag), err))
+		return errors.NewErrorWrapped(output.CmdOutputError(viper.GetString(cliflags.OutputFlag), err), err)
+	}
+	if printOnly {
+		return nil
 	}
 
 	if string(res) == "" {
@@ -332,12 +569,20 @@ func (op *OperationCmd) makeRequest(cmd *cobra.Command, args []string) error {
 		res = []byte(fmt.Sprintf(`{"key": %q}`, urlParms[len(urlParms)-1]))
 	}
 
-	output, err := output.CmdOutput(cmd.Use, viper.GetString(cliflags.OutputFlag), res)
+	output, err := output.CmdOutput(
+		cmd.Use,
+		viper.GetString(cliflags.OutputFlag),
+		res,
+		output.CmdOutputOpts{
+			Columns: viper.GetStringSlice(cliflags.ColumnsFlag),
+			Query:   viper.GetString(cliflags.QueryFlag),
+		},
+	)
 	if err != nil {
 		return errors.NewError(err.Error())
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), output+"\n")
+	fmt.Fprint(cmd.OutOrStdout(), output+"\n")
 
 	return nil
 }
@@ -0,0 +1,178 @@
+package resources
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"ldcli/internal/errors"
+)
+
+//go:embed request_schemas.json
+var requestSchemasFile []byte
+
+var (
+	requestSchemas     *openapi3.T
+	requestSchemasErr  error
+	requestSchemasOnce sync.Once
+)
+
+func loadRequestSchemas() (*openapi3.T, error) {
+	requestSchemasOnce.Do(func() {
+		requestSchemas, requestSchemasErr = openapi3.NewLoader().LoadFromData(requestSchemasFile)
+	})
+
+	return requestSchemas, requestSchemasErr
+}
+
+// requestBodySchema gets the JSON request body schema for the operation, or nil if the operation
+// doesn't have one.
+func requestBodySchema(method, path string) (*openapi3.Schema, error) {
+	spec, err := loadRequestSchemas()
+	if err != nil {
+		return nil, err
+	}
+
+	pathItem := spec.Paths.Value(path)
+	if pathItem == nil {
+		return nil, nil
+	}
+	op := pathItem.GetOperation(strings.ToUpper(method))
+	if op == nil || op.RequestBody == nil || op.RequestBody.Value == nil {
+		return nil, nil
+	}
+	mediaType := op.RequestBody.Value.Content.Get("application/json")
+	if mediaType == nil || mediaType.Schema == nil {
+		return nil, nil
+	}
+
+	return mediaType.Schema.Value, nil
+}
+
+// validateData checks the request body against the operation's schema and returns an error listing
+// every violation along with the JSON pointer to the invalid value.
+func validateData(method, path string, data []byte) error {
+	schema, err := requestBodySchema(method, path)
+	if err != nil {
+		return err
+	}
+	if schema == nil {
+		return nil
+	}
+
+	// validate the JSON we're sending since YAML input can have values JSON doesn't, such as ints
+	var value interface{}
+	err = json.Unmarshal(data, &value)
+	if err != nil {
+		return err
+	}
+	if value == nil {
+		return nil
+	}
+
+	// the API also accepts a JSON patch without the object that wraps it with a comment
+	if _, ok := value.([]interface{}); ok && schema.Properties["patch"] != nil {
+		schema = schema.Properties["patch"].Value
+	}
+
+	violations := make([]string, 0)
+	err = schema.VisitJSON(value, openapi3.MultiErrors(), openapi3.VisitAsRequest())
+	if err != nil {
+		violations = append(violations, schemaViolations(err)...)
+	}
+	violations = append(violations, unknownPropertyViolations(schema, value, nil)...)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	sort.Strings(violations)
+
+	return errors.NewError(fmt.Sprintf(
+		"data is invalid:\n  %s\n\nUse --skip-validation to send the request anyway",
+		strings.Join(violations, "\n  "),
+	))
+}
+
+// schemaViolations flattens the errors from validating a schema into one message per violation.
+func schemaViolations(err error) []string {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		violations := make([]string, 0, len(e))
+		for _, err := range e {
+			violations = append(violations, schemaViolations(err)...)
+		}
+
+		return violations
+	case *openapi3.SchemaError:
+		if e.Origin != nil {
+			if _, ok := e.Origin.(openapi3.MultiError); ok {
+				return schemaViolations(e.Origin)
+			}
+		}
+
+		return []string{fmt.Sprintf("%s: %s", jsonPointer(e.JSONPointer()), e.Reason)}
+	default:
+		return []string{err.Error()}
+	}
+}
+
+// unknownPropertyViolations finds properties that aren't in the schema. The spec allows additional
+// properties unless it says otherwise, but the API rejects most of them, so a typo in a field name
+// would otherwise only be caught by the API.
+func unknownPropertyViolations(schema *openapi3.Schema, value interface{}, path []string) []string {
+	if schema == nil {
+		return nil
+	}
+
+	violations := make([]string, 0)
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, propValue := range v {
+			propPath := append(path[:len(path):len(path)], key)
+			prop, ok := schema.Properties[key]
+			switch {
+			case ok && prop.Value != nil:
+				violations = append(violations, unknownPropertyViolations(prop.Value, propValue, propPath)...)
+			case schema.AdditionalProperties.Schema != nil:
+				violations = append(
+					violations,
+					unknownPropertyViolations(schema.AdditionalProperties.Schema.Value, propValue, propPath)...,
+				)
+			case len(schema.Properties) > 0 && schema.AdditionalProperties.Has == nil:
+				// the schema validation already reports these if additional properties are disallowed
+				violations = append(violations, fmt.Sprintf("%s: property %q is unsupported", jsonPointer(path), key))
+			}
+		}
+	case []interface{}:
+		if schema.Items == nil {
+			return nil
+		}
+		for i, item := range v {
+			violations = append(
+				violations,
+				unknownPropertyViolations(schema.Items.Value, item, append(path[:len(path):len(path)], strconv.Itoa(i)))...,
+			)
+		}
+	}
+
+	return violations
+}
+
+func jsonPointer(path []string) string {
+	escaped := make([]string, 0, len(path))
+	for _, p := range path {
+		escaped = append(escaped, escapeJSONPointer(p))
+	}
+
+	return "/" + strings.Join(escaped, "/")
+}
+
+func escapeJSONPointer(s string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(s)
+}
@@ -0,0 +1,87 @@
+package resources_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ldcli/cmd"
+	"ldcli/internal/analytics"
+	"ldcli/internal/resources"
+)
+
+func TestDataValidation(t *testing.T) {
+	t.Run("with invalid data returns every violation", func(t *testing.T) {
+		mockClient := &resources.MockClient{}
+		args := []string{
+			"teams", "create",
+			"--access-token", "abcd1234",
+			"--data", `{"key": "team-key", "nmae": "Team Name", "memberIDs": [1]}`,
+		}
+
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: mockClient,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `/: property "nmae" is unsupported`)
+		assert.Contains(t, err.Error(), `/memberIDs/0: value must be a string`)
+		assert.Contains(t, err.Error(), `/name: property "name" is missing`)
+		assert.Nil(t, mockClient.Input)
+	})
+
+	t.Run("with a JSON patch without a comment", func(t *testing.T) {
+		mockClient := &resources.MockClient{
+			Response: []byte(`{"key": "test-flag", "name": "New Name"}`),
+		}
+		args := []string{
+			"flags", "update",
+			"--access-token", "abcd1234",
+			"--project", "test-proj",
+			"--flag", "test-flag",
+			"--data", `[{"op": "replace", "path": "/name", "value": "New Name"}]`,
+		}
+
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: mockClient,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `[{"op": "replace", "path": "/name", "value": "New Name"}]`, string(mockClient.Input))
+	})
+
+	t.Run("with skip validation sends invalid data", func(t *testing.T) {
+		mockClient := &resources.MockClient{
+			Response: []byte(`{"key": "team-key", "name": "Team Name"}`),
+		}
+		args := []string{
+			"teams", "create",
+			"--access-token", "abcd1234",
+			"--data", `{"key": "team-key", "nmae": "Team Name"}`,
+			"--skip-validation",
+		}
+
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: mockClient,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"key": "team-key", "nmae": "Team Name"}`, string(mockClient.Input))
+	})
+}
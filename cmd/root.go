@@ -3,29 +3,36 @@
 package cmd
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
 
 	cmdAnalytics "ldcli/cmd/analytics"
 	"ldcli/cmd/cliflags"
 	configcmd "ldcli/cmd/config"
 	flagscmd "ldcli/cmd/flags"
+	logincmd "ldcli/cmd/login"
 	memberscmd "ldcli/cmd/members"
 	resourcecmd "ldcli/cmd/resources"
 	"ldcli/internal/analytics"
 	"ldcli/internal/config"
+	"ldcli/internal/debug"
 	"ldcli/internal/environments"
 	errs "ldcli/internal/errors"
 	"ldcli/internal/flags"
 	"ldcli/internal/members"
+	"ldcli/internal/output"
 	"ldcli/internal/projects"
 	"ldcli/internal/resources"
 )
@@ -84,6 +91,7 @@ func NewRootCommand(
 				"completion",
 				"config",
 				"help",
+				"login",
 			} {
 				if cmd.HasParent() && cmd.Parent().Name() == name {
 					cmd.DisableFlagParsing = true
@@ -103,6 +111,9 @@ func NewRootCommand(
 	rootCmd := &RootCmd{
 		cmd: cmd,
 	}
+	cmd.SetFlagErrorFunc(func(c *cobra.Command, err error) error {
+		return errs.NewUsageError(err)
+	})
 
 	hf := cmd.HelpFunc()
 	cmd.SetHelpFunc(func(c *cobra.Command, args []string) {
@@ -171,6 +182,16 @@ func NewRootCommand(
 		return nil, err
 	}
 
+	cmd.PersistentFlags().Int(
+		cliflags.MaxRetriesFlag,
+		resources.DefaultMaxRetries,
+		cliflags.MaxRetriesFlagDescription,
+	)
+	err = viper.BindPFlag(cliflags.MaxRetriesFlag, cmd.PersistentFlags().Lookup(cliflags.MaxRetriesFlag))
+	if err != nil {
+		return nil, err
+	}
+
 	cmd.PersistentFlags().StringP(
 		cliflags.OutputFlag,
 		"o",
@@ -182,8 +203,81 @@ func NewRootCommand(
 		return nil, err
 	}
 
+	cmd.PersistentFlags().StringSlice(
+		cliflags.ColumnsFlag,
+		[]string{},
+		cliflags.ColumnsFlagDescription,
+	)
+	err = viper.BindPFlag(cliflags.ColumnsFlag, cmd.PersistentFlags().Lookup(cliflags.ColumnsFlag))
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.PersistentFlags().Bool(
+		cliflags.DryRunFlag,
+		false,
+		cliflags.DryRunFlagDescription,
+	)
+	err = viper.BindPFlag(cliflags.DryRunFlag, cmd.PersistentFlags().Lookup(cliflags.DryRunFlag))
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.PersistentFlags().Bool(
+		cliflags.PrintCurlFlag,
+		false,
+		cliflags.PrintCurlFlagDescription,
+	)
+	err = viper.BindPFlag(cliflags.PrintCurlFlag, cmd.PersistentFlags().Lookup(cliflags.PrintCurlFlag))
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.PersistentFlags().String(
+		cliflags.ProfileFlag,
+		"",
+		cliflags.ProfileFlagDescription,
+	)
+	err = viper.BindPFlag(cliflags.ProfileFlag, cmd.PersistentFlags().Lookup(cliflags.ProfileFlag))
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.PersistentFlags().String(
+		cliflags.QueryFlag,
+		"",
+		cliflags.QueryFlagDescription,
+	)
+	err = viper.BindPFlag(cliflags.QueryFlag, cmd.PersistentFlags().Lookup(cliflags.QueryFlag))
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.PersistentFlags().Duration(
+		cliflags.TimeoutFlag,
+		resources.DefaultTimeout,
+		cliflags.TimeoutFlagDescription,
+	)
+	err = viper.BindPFlag(cliflags.TimeoutFlag, cmd.PersistentFlags().Lookup(cliflags.TimeoutFlag))
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.PersistentFlags().CountP(
+		cliflags.VerboseFlag,
+		"v",
+		cliflags.VerboseFlagDescription,
+	)
+	err = viper.BindPFlag(cliflags.VerboseFlag, cmd.PersistentFlags().Lookup(cliflags.VerboseFlag))
+	if err != nil {
+		return nil, err
+	}
+
 	configCmd := configcmd.NewConfigCmd(analyticsTrackerFn)
 	cmd.AddCommand(configCmd.Cmd())
+	cmd.AddCommand(logincmd.NewLoginCmd(clients.ResourcesClient))
+	cmd.AddCommand(logincmd.NewLogoutCmd(clients.ResourcesClient))
+	cmd.AddCommand(logincmd.NewWhoAmICmd(clients.ResourcesClient))
 	cmd.AddCommand(NewQuickStartCmd(analyticsTrackerFn, clients.EnvironmentsClient, clients.FlagsClient))
 	cmd.AddCommand(resourcecmd.NewResourcesCmd())
 	resourcecmd.AddAllResourceCmds(cmd, clients.ResourcesClient, analyticsTrackerFn)
@@ -191,6 +285,14 @@ func NewRootCommand(
 	// add non-generated commands
 	for _, c := range cmd.Commands() {
 		if c.Name() == "flags" {
+			c.AddCommand(flagscmd.NewApplyCmd(clients.ResourcesClient))
+			c.AddCommand(flagscmd.NewBrowseCmd(clients.ResourcesClient, clients.FlagsClient))
+			c.AddCommand(flagscmd.NewCloneCmd(clients.ResourcesClient))
+			c.AddCommand(flagscmd.NewDiffCmd(clients.ResourcesClient))
+			c.AddCommand(flagscmd.NewEvaluateCmd(clients.ResourcesClient))
+			c.AddCommand(flagscmd.NewExportCmd(clients.ResourcesClient))
+			c.AddCommand(flagscmd.NewPromoteCmd(clients.ResourcesClient))
+			c.AddCommand(flagscmd.NewReportCmd(clients.ResourcesClient))
 			c.AddCommand(flagscmd.NewToggleOnCmd(clients.ResourcesClient))
 			c.AddCommand(flagscmd.NewToggleOffCmd(clients.ResourcesClient))
 		}
@@ -222,7 +324,8 @@ func Execute(version string) {
 		true,
 	)
 	if err != nil {
-		log.Fatal(err)
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
 	}
 
 	// change the completion command help
@@ -234,6 +337,7 @@ See each command's help for details on how to use the generated script.`, rootCm
 		rootCmd.Cmd().AddCommand(completionCmd)
 	}
 
+	cobra.OnInitialize(useDebugLevel)
 	cobra.AddTemplateFunc("WrappedRequiredFlagUsages", WrappedRequiredFlagUsages)
 	cobra.AddTemplateFunc("WrappedOptionalFlagUsages", WrappedOptionalFlagUsages)
 	cobra.AddTemplateFunc("HasRequiredFlags", HasRequiredFlags)
@@ -248,7 +352,11 @@ See each command's help for details on how to use the generated script.`, rootCm
 		outcome = analytics.HELP
 	case err != nil:
 		outcome = analytics.ERROR
-		fmt.Fprintln(os.Stderr, err.Error())
+		// cobra doesn't have an error type for unknown commands
+		if strings.HasPrefix(err.Error(), "unknown command") {
+			err = errs.NewUsageError(err)
+		}
+		printError(os.Stderr, err)
 	default:
 		outcome = analytics.SUCCESS
 	}
@@ -269,6 +377,34 @@ See each command's help for details on how to use the generated script.`, rootCm
 	}
 
 	analyticsClient.Wait()
+
+	if err != nil && !rootCmd.HelpCalled() {
+		os.Exit(errs.ExitCode(err))
+	}
+}
+
+// printError writes the error as JSON when the output is JSON so scripts can read it. Otherwise
+// it writes the message.
+func printError(w io.Writer, err error) {
+	if viper.GetString(cliflags.OutputFlag) == "json" {
+		envelope, jsonErr := json.Marshal(output.NewErrorEnvelope(err))
+		if jsonErr == nil {
+			fmt.Fprintln(w, string(envelope))
+			return
+		}
+	}
+
+	fmt.Fprintln(w, err.Error())
+}
+
+// useDebugLevel turns on logging requests when the verbose flag or LD_DEBUG environment variable
+// is set. It runs after the flags are parsed.
+func useDebugLevel() {
+	level := debug.Level(viper.GetInt(cliflags.VerboseFlag))
+	if envLevel := debug.LevelFromEnv(os.Getenv(debug.EnvVar)); envLevel > level {
+		level = envLevel
+	}
+	debug.SetLevel(level)
 }
 
 // setFlagsFromConfig reads in the config file if it exists and uses any flag values for commands.
@@ -284,6 +420,132 @@ func setFlagsFromConfig() error {
 
 	_ = viper.ReadInConfig()
 
+	flags := parseConfigFlags(os.Args[1:])
+	err = useProfile(flags)
+	if err != nil {
+		return err
+	}
+
+	err = useLocalConfig(os.Stderr)
+	if err != nil {
+		return err
+	}
+
+	return useCredentialStore(flags)
+}
+
+// useLocalConfig adds the settings from a project's local config file in the current directory
+// or one of its parents. They take precedence over the user's config file.
+func useLocalConfig(warnings io.Writer) error {
+	dir, err := os.Getwd()
+	if err != nil {
+		return nil
+	}
+	path, ok := config.FindLocalConfigFile(dir)
+	if !ok {
+		return nil
+	}
+
+	settings, ignored, err := config.ReadLocalConfigFile(path)
+	if err != nil {
+		return err
+	}
+	for _, key := range ignored {
+		fmt.Fprintf(warnings, "warning: %s can't be set in %s and is ignored\n", key, path)
+	}
+
+	return viper.MergeConfigMap(settings)
+}
+
+// parseConfigFlags parses the flags that change how the config file is read. Flags aren't parsed
+// yet when reading the config file, so it looks for them in the arguments.
+func parseConfigFlags(args []string) *pflag.FlagSet {
+	flags := pflag.NewFlagSet("config", pflag.ContinueOnError)
+	flags.ParseErrorsWhitelist.UnknownFlags = true
+	flags.SetOutput(io.Discard)
+	flags.String(cliflags.AccessTokenFlag, "", "")
+	flags.String(cliflags.ProfileFlag, os.Getenv("LD_PROFILE"), "")
+	_ = flags.Parse(args)
+
+	return flags
+}
+
+// useProfile adds the settings from the profile set by the profile flag or LD_PROFILE environment
+// variable on top of the settings at the top level of the config file.
+func useProfile(flags *pflag.FlagSet) error {
+	profile, _ := flags.GetString(cliflags.ProfileFlag)
+	if profile == "" || profile == config.DefaultProfile {
+		return nil
+	}
+
+	rawConfig := make(map[string]interface{})
+	data, err := os.ReadFile(viper.ConfigFileUsed())
+	if err == nil {
+		err = yaml.Unmarshal(data, &rawConfig)
+		if err != nil {
+			return err
+		}
+	}
+
+	profileConfig, ok := config.Profile(rawConfig, profile, false)
+	if !ok {
+		// the config command can create the profile
+		if flags.Arg(0) == "config" {
+			return nil
+		}
+
+		return errs.NewError(fmt.Sprintf("profile %s not found. Use `ldcli config --profile %s --set <key> <value>` to create it", profile, profile))
+	}
+
+	sharedYAML, err := yaml.Marshal(config.SharedSettings(rawConfig))
+	if err != nil {
+		return err
+	}
+	err = viper.ReadConfig(bytes.NewReader(sharedYAML))
+	if err != nil {
+		return err
+	}
+
+	return viper.MergeConfigMap(profileConfig)
+}
+
+// useCredentialStore gets the access token from the credential helper or keyring when the config
+// uses one. An access token from a flag, environment variable, or the config file takes
+// precedence, so the credential helper doesn't run when it isn't needed.
+func useCredentialStore(flags *pflag.FlagSet) error {
+	accessToken, _ := flags.GetString(cliflags.AccessTokenFlag)
+	if accessToken != "" || os.Getenv("LD_ACCESS_TOKEN") != "" || viper.IsSet(cliflags.AccessTokenFlag) {
+		return nil
+	}
+	// the config command manages the credential store itself
+	if flags.Arg(0) == "config" {
+		return nil
+	}
+
+	store, ok := config.NewCredentialStore(
+		config.ConfigFile{
+			CredentialHelper: viper.GetString(cliflags.CredentialHelper),
+			CredentialStore:  viper.GetString(cliflags.CredentialStore),
+		},
+		viper.ConfigFileUsed(),
+	)
+	if !ok {
+		return nil
+	}
+
+	profile, _ := flags.GetString(cliflags.ProfileFlag)
+	if profile == "" {
+		profile = config.DefaultProfile
+	}
+	accessToken, err := store.Get(profile)
+	switch {
+	case errors.Is(err, config.ErrCredentialNotFound):
+		return nil
+	case err != nil:
+		return errs.NewError(err.Error())
+	}
+	viper.SetDefault(cliflags.AccessTokenFlag, accessToken)
+
 	return nil
 }
 
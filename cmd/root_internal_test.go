@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ldcli/cmd/cliflags"
+)
+
+func TestUseProfile(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.yml")
+	require.NoError(t, os.WriteFile(configFile, []byte(`access-token: prod-token
+analytics-opt-out: true
+project: default
+profiles:
+  fed:
+    base-uri: https://app.launchdarkly.us
+    project: fed-project
+`), 0600))
+	viper.SetConfigType("yml")
+	viper.SetConfigFile(configFile)
+	defer viper.Reset()
+	require.NoError(t, viper.ReadInConfig())
+
+	err := useProfile(parseConfigFlags([]string{"flags", "list", "--profile", "fed"}))
+
+	require.NoError(t, err)
+	assert.True(t, viper.GetBool(cliflags.AnalyticsOptOut))
+	assert.Equal(t, "https://app.launchdarkly.us", viper.GetString(cliflags.BaseURIFlag))
+	assert.Equal(t, "fed-project", viper.GetString(cliflags.ProjectFlag))
+	assert.False(t, viper.IsSet(cliflags.AccessTokenFlag))
+}
@@ -30,6 +30,11 @@ func Validate() cobra.PositionalArgs {
 			return CmdError(err, cmd.CommandPath(), viper.GetString(cliflags.BaseURIFlag))
 		}
 
+		err = cmd.ValidateFlagGroups()
+		if err != nil {
+			return CmdError(err, cmd.CommandPath(), viper.GetString(cliflags.BaseURIFlag))
+		}
+
 		err = validateOutput(viper.GetString(cliflags.OutputFlag))
 		if err != nil {
 			return CmdError(err, cmd.CommandPath(), viper.GetString(cliflags.BaseURIFlag))
@@ -43,18 +48,39 @@ func CmdError(err error, commandPath string, baseURI string) error {
 	errorMessage := err.Error() + "."
 
 	// show additional help if a missing flag can be set in the config file
-	if strings.Contains(err.Error(), cliflags.AccessTokenFlag) {
-		errorMessage += "\n\n"
+	isAuthError := strings.Contains(err.Error(), cliflags.AccessTokenFlag)
+	switch {
+	case isAuthError:
+		errorMessage += "\n\nUse `ldcli login` to log in and save an access token.\n"
 		if baseURI != "" {
 			errorMessage += fmt.Sprintf("Go to %s/settings/authorization to create an access token.\n", baseURI)
 		}
 		errorMessage += fmt.Sprintf("Use `ldcli config --set %s <value>` to configure the value to persist across CLI commands.\n\n", cliflags.AccessTokenFlag)
-	} else {
+	case isMissingFlagError(err, cliflags.ProjectFlag), isMissingFlagError(err, cliflags.EnvironmentFlag):
+		errorMessage += "\n\n"
+		for _, f := range []string{cliflags.ProjectFlag, cliflags.EnvironmentFlag} {
+			if isMissingFlagError(err, f) {
+				errorMessage += fmt.Sprintf("Use `ldcli config --set %s <value>` to set a default %s for every command.\n", f, f)
+			}
+		}
+		errorMessage += "\n"
+	default:
 		errorMessage += " "
 	}
 	errorMessage += fmt.Sprintf("See `%s --help` for supported flags and usage.", commandPath)
 
-	return errors.New(errorMessage)
+	// a missing access token is an authentication problem instead of a usage problem
+	if isAuthError {
+		return errs.NewUnauthorizedError(errors.New(errorMessage))
+	}
+
+	return errs.NewUsageError(errors.New(errorMessage))
+}
+
+// isMissingFlagError checks if the error is from a required flag not being set. The error lists
+// the flags in quotes, such as required flag(s) "environment", "flag" not set.
+func isMissingFlagError(err error, flag string) bool {
+	return strings.HasPrefix(err.Error(), "required flag") && strings.Contains(err.Error(), fmt.Sprintf("%q", flag))
 }
 
 func validateOutput(outputFlag string) error {
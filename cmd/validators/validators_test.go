@@ -7,12 +7,14 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"ldcli/cmd/validators"
+	errs "ldcli/internal/errors"
 )
 
 func TestCmdError(t *testing.T) {
 	t.Run("with missing access-token value shows additional help", func(t *testing.T) {
 		var expected string
 		expected += "required flag(s) \"access-token\" not set.\n\n"
+		expected += "Use `ldcli login` to log in and save an access token.\n"
 		expected += "Go to http://test.com/settings/authorization to create an access token.\n"
 		expected += "Use `ldcli config --set access-token <value>` to configure the value to persist across CLI commands.\n\n"
 		expected += "See `ldcli command action --help` for supported flags and usage."
@@ -24,6 +26,7 @@ func TestCmdError(t *testing.T) {
 		)
 
 		assert.EqualError(t, err, expected)
+		assert.Equal(t, errs.ExitCodeUnauthorized, errs.ExitCode(err))
 	})
 
 	t.Run("with missing other flag value shows regular help", func(t *testing.T) {
@@ -35,6 +38,21 @@ func TestCmdError(t *testing.T) {
 			"",
 		)
 
+		assert.EqualError(t, err, expected)
+		assert.Equal(t, errs.ExitCodeUsage, errs.ExitCode(err))
+	})
+	t.Run("with missing project value shows how to set a default", func(t *testing.T) {
+		var expected string
+		expected += "required flag(s) \"flag\", \"project\" not set.\n\n"
+		expected += "Use `ldcli config --set project <value>` to set a default project for every command.\n\n"
+		expected += "See `ldcli command action --help` for supported flags and usage."
+
+		err := validators.CmdError(
+			errors.New(`required flag(s) "flag", "project" not set`),
+			"ldcli command action",
+			"",
+		)
+
 		assert.EqualError(t, err, expected)
 	})
 }
@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/mock"
+
+	"ldcli/internal/debug"
 )
 
 type TrackerFn func(accessToken string, baseURI string, optOut bool) Tracker
@@ -26,7 +28,8 @@ func (fn ClientFn) Tracker(version string) TrackerFn {
 
 		return &Client{
 			httpClient: &http.Client{
-				Timeout: time.Second * 3,
+				Timeout:   time.Second * 3,
+				Transport: debug.NewTransport(http.DefaultTransport),
 			},
 			id:          fn.ID,
 			version:     version,
@@ -0,0 +1,397 @@
+package browse
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/wordwrap"
+
+	"ldcli/internal/flags"
+	"ldcli/internal/resources"
+)
+
+const (
+	defaultHeight    = 20
+	defaultWidth     = 100
+	footerHeight     = 4
+	listWidth        = 40
+	throttleDuration = time.Second
+)
+
+var (
+	detailStyle = lipgloss.NewStyle().
+			BorderStyle(lipgloss.NormalBorder()).
+			BorderForeground(lipgloss.Color("62")).
+			BorderLeft(true).
+			PaddingLeft(2)
+	flagStyle         = lipgloss.NewStyle().PaddingLeft(2)
+	listStyle         = lipgloss.NewStyle().Width(listWidth)
+	mutedStyle        = lipgloss.NewStyle().Foreground(lipgloss.Color("245"))
+	selectedFlagStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("170"))
+	titleStyle        = lipgloss.NewStyle().Bold(true)
+	offStyle          = lipgloss.NewStyle().Background(lipgloss.Color("#646a73")).Padding(0, 1)
+	onStyle           = lipgloss.NewStyle().Background(lipgloss.Color("#3d9c51")).Padding(0, 1)
+)
+
+// focus is the part of the browser that key presses go to.
+type focus int
+
+const (
+	focusFlags focus = iota
+	focusEnvironments
+	focusConfirm
+)
+
+// Model lists the flags in a project, shows the targeting for the selected flag in each
+// environment, and toggles the flag in an environment after the user confirms it.
+type Model struct {
+	accessToken string
+	baseURI     string
+	client      flags.Client
+	detail      viewport.Model
+	envIndex    int
+	err         error
+	focus       focus
+	help        help.Model
+	list        list.Model
+	loaded      bool
+	projKey     string
+	quitting    bool
+	// resourcesClient lists the flags, and client toggles them
+	resourcesClient resources.Client
+	spinner         spinner.Model
+
+	// toggling is set while the request to toggle a flag is in progress, and throttling is set for
+	// a short time after it finishes, so a toggle can't be confirmed again by holding down a key.
+	throttling bool
+	toggling   bool
+}
+
+func NewModel(
+	resourcesClient resources.Client,
+	client flags.Client,
+	accessToken string,
+	baseURI string,
+	projKey string,
+) tea.Model {
+	s := spinner.New()
+	s.Spinner = spinner.Points
+
+	l := list.New(nil, flagDelegate{}, listWidth, defaultHeight)
+	l.Title = fmt.Sprintf("Flags in %s", projKey)
+	l.Styles.Title = titleStyle
+	l.Styles.TitleBar = lipgloss.NewStyle().PaddingBottom(1)
+	l.SetShowHelp(false)
+	l.SetShowPagination(true)
+	l.SetShowStatusBar(true)
+	l.SetStatusBarItemName("flag", "flags")
+
+	m := Model{
+		accessToken:     accessToken,
+		baseURI:         baseURI,
+		client:          client,
+		detail:          viewport.New(defaultWidth-listWidth, defaultHeight),
+		help:            help.New(),
+		list:            l,
+		projKey:         projKey,
+		resourcesClient: resourcesClient,
+		spinner:         s,
+	}
+	m.detail.Style = detailStyle
+
+	return m
+}
+
+// Init sends commands when the model is created that will:
+// * show a spinner while the flags load
+// * fetch the flags in the project
+func (m Model) Init() tea.Cmd {
+	return tea.Batch(m.spinner.Tick, fetchFlags(m.resourcesClient, m.accessToken, m.baseURI, m.projKey))
+}
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.setSize(msg.Width, msg.Height)
+	case tea.KeyMsg:
+		if key.Matches(msg, BindingQuit) {
+			m.quitting = true
+			return m, tea.Quit
+		}
+		switch m.focus {
+		case focusFlags:
+			m, cmd = m.updateFlags(msg)
+		case focusEnvironments:
+			m, cmd = m.updateEnvironments(msg)
+		case focusConfirm:
+			m, cmd = m.updateConfirm(msg)
+		}
+	case fetchedFlagsMsg:
+		m.loaded = true
+		items := make([]list.Item, 0, len(msg.flags))
+		for _, f := range msg.flags {
+			items = append(items, f)
+		}
+		cmd = m.list.SetItems(items)
+	case toggledFlagMsg:
+		m.toggling = false
+		m.throttling = true
+		cmd = tea.Batch(m.setFlag(msg.flag), throttleFlagToggle())
+	case flagToggleThrottleMsg:
+		m.throttling = false
+	case errMsg:
+		m.toggling = false
+		m.err = msg.err
+	case spinner.TickMsg:
+		if !m.loaded || m.toggling {
+			m.spinner, cmd = m.spinner.Update(msg)
+		}
+	default:
+		// the list filters flags in the background and sends the matches back
+		m.list, cmd = m.list.Update(msg)
+	}
+
+	m.updateDetail()
+
+	return m, cmd
+}
+
+func (m Model) updateFlags(msg tea.KeyMsg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	// the list handles every key while the user types a filter
+	if m.list.FilterState() == list.Filtering {
+		m.list, cmd = m.list.Update(msg)
+		return m, cmd
+	}
+
+	switch {
+	case key.Matches(msg, BindingSelect):
+		if f, ok := m.selectedFlag(); ok && len(f.environments) > 0 {
+			m.focus = focusEnvironments
+			m.envIndex = 0
+		}
+	case key.Matches(msg, BindingShowFullHelp):
+		m.help.ShowAll = !m.help.ShowAll
+	default:
+		m.list, cmd = m.list.Update(msg)
+	}
+
+	return m, cmd
+}
+
+func (m Model) updateEnvironments(msg tea.KeyMsg) (Model, tea.Cmd) {
+	f, ok := m.selectedFlag()
+	if !ok {
+		m.focus = focusFlags
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(msg, BindingBack):
+		m.focus = focusFlags
+	case key.Matches(msg, BindingCursorUp):
+		if m.envIndex > 0 {
+			m.envIndex--
+		}
+	case key.Matches(msg, BindingCursorDown):
+		if m.envIndex < len(f.environments)-1 {
+			m.envIndex++
+		}
+	case key.Matches(msg, BindingToggle):
+		// don't toggle the flag again until the last toggle has finished
+		if m.toggling || m.throttling {
+			return m, nil
+		}
+		m.err = nil
+		m.focus = focusConfirm
+	case key.Matches(msg, BindingShowFullHelp):
+		m.help.ShowAll = !m.help.ShowAll
+	}
+
+	return m, nil
+}
+
+func (m Model) updateConfirm(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, BindingConfirm):
+		m.focus = focusEnvironments
+		f, env, ok := m.selectedEnvironment()
+		if !ok {
+			return m, nil
+		}
+		m.toggling = true
+
+		return m, tea.Batch(
+			m.spinner.Tick,
+			toggleFlag(m.client, m.accessToken, m.baseURI, m.projKey, f.key, env.key, !env.on),
+		)
+	case key.Matches(msg, BindingCancel):
+		m.focus = focusEnvironments
+	}
+
+	return m, nil
+}
+
+func (m Model) View() string {
+	if m.quitting {
+		return ""
+	}
+	if !m.loaded {
+		if m.err != nil {
+			return footerView(m.help.View(keyMap{Quit: BindingQuit}), m.err)
+		}
+
+		return m.spinner.View() + " Loading flags" + footerView(m.help.View(listKeys), nil)
+	}
+	if len(m.list.Items()) == 0 {
+		return fmt.Sprintf("There are no flags in %s.", m.projKey) + footerView(m.help.View(listKeys), m.err)
+	}
+
+	keys := listKeys
+	var status string
+	switch {
+	case m.focus == focusConfirm:
+		keys = confirmKeys
+		if f, env, ok := m.selectedEnvironment(); ok {
+			status = fmt.Sprintf("\nTurn %s %s in %s?", f.key, onOff(!env.on), env.name)
+		}
+	case m.toggling:
+		keys = environmentKeys
+		status = "\n" + m.spinner.View() + " Toggling flag"
+	case m.focus == focusEnvironments:
+		keys = environmentKeys
+	}
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, listStyle.Render(m.list.View()), m.detail.View()) +
+		status +
+		footerView(m.help.View(keys), m.err)
+}
+
+func (m *Model) setSize(width, height int) {
+	listHeight := height - footerHeight
+	if listHeight < 1 {
+		listHeight = 1
+	}
+	m.list.SetSize(listWidth, listHeight)
+	m.detail.Width = width - listWidth
+	m.detail.Height = listHeight
+	m.help.Width = width
+}
+
+// setFlag replaces the flag in the list with the updated flag.
+func (m *Model) setFlag(f flag) tea.Cmd {
+	for i, item := range m.list.Items() {
+		if item.(flag).key == f.key {
+			return m.list.SetItem(i, f)
+		}
+	}
+
+	return nil
+}
+
+func (m Model) selectedFlag() (flag, bool) {
+	f, ok := m.list.SelectedItem().(flag)
+
+	return f, ok
+}
+
+func (m Model) selectedEnvironment() (flag, flagEnvironment, bool) {
+	f, ok := m.selectedFlag()
+	if !ok || m.envIndex >= len(f.environments) {
+		return flag{}, flagEnvironment{}, false
+	}
+
+	return f, f.environments[m.envIndex], true
+}
+
+// updateDetail shows the selected flag in the detail pane and scrolls to the selected environment.
+func (m *Model) updateDetail() {
+	f, ok := m.selectedFlag()
+	if !ok {
+		m.detail.SetContent("")
+		return
+	}
+
+	width := m.detail.Width - detailStyle.GetHorizontalFrameSize()
+	lines := []string{titleStyle.Render(f.name) + " " + mutedStyle.Render(f.key)}
+	if f.description != "" {
+		lines = append(lines, wordwrap.String(f.description, width))
+	}
+	details := []string{"Kind: " + f.kind}
+	if len(f.tags) > 0 {
+		details = append(details, "Tags: "+strings.Join(f.tags, ", "))
+	}
+	lines = append(lines, strings.Join(details, "  "))
+	lines = append(lines, wordwrap.String("Variations: "+strings.Join(f.variations, ", "), width), "", "Environments")
+
+	var cursorLine int
+	for i, env := range f.environments {
+		cursor := "  "
+		if m.focus != focusFlags && i == m.envIndex {
+			cursor = selectedFlagStyle.Render("> ")
+			cursorLine = len(lines)
+		}
+		toggle := offStyle.Render("OFF")
+		if env.on {
+			toggle = onStyle.Render("ON")
+		}
+		lines = append(
+			lines,
+			cursor+toggle+" "+env.name+" "+mutedStyle.Render(env.key),
+			"    "+env.targetingSummary(),
+			"    "+env.fallthroughSummary(f.variations),
+		)
+	}
+	m.detail.SetContent(strings.Join(lines, "\n"))
+
+	// keep the selected environment and its summary in view
+	switch {
+	case m.focus == focusFlags:
+		m.detail.GotoTop()
+	case cursorLine < m.detail.YOffset:
+		m.detail.SetYOffset(cursorLine)
+	case cursorLine+3 > m.detail.YOffset+m.detail.Height:
+		m.detail.SetYOffset(cursorLine + 3 - m.detail.Height)
+	}
+}
+
+func onOff(on bool) string {
+	if on {
+		return "ON"
+	}
+
+	return "OFF"
+}
+
+type flagDelegate struct{}
+
+func (d flagDelegate) Height() int                             { return 1 }
+func (d flagDelegate) Spacing() int                            { return 0 }
+func (d flagDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+func (d flagDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	f, ok := listItem.(flag)
+	if !ok {
+		return
+	}
+
+	key := f.key
+	if len(key) > listWidth-4 {
+		key = key[:listWidth-5] + "…"
+	}
+	if index == m.Index() {
+		fmt.Fprint(w, selectedFlagStyle.Render("> "+key))
+		return
+	}
+
+	fmt.Fprint(w, flagStyle.Render(key))
+}
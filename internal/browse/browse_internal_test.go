@@ -0,0 +1,131 @@
+package browse
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ldcli/internal/flags"
+	"ldcli/internal/resources"
+)
+
+const flagsResponse = `{
+	"items": [
+		{
+			"key": "test-flag",
+			"name": "Test flag",
+			"kind": "boolean",
+			"tags": ["beta"],
+			"variations": [{"value": true}, {"name": "Disabled", "value": false}],
+			"environments": {
+				"test": {
+					"_environmentName": "Test",
+					"on": false,
+					"fallthrough": {"rollout": {"variations": []}},
+					"offVariation": 1
+				},
+				"production": {
+					"_environmentName": "Production",
+					"on": true,
+					"fallthrough": {"variation": 0},
+					"offVariation": 1,
+					"rules": [{}, {}],
+					"targets": [{"values": ["user-1", "user-2"]}],
+					"contextTargets": [{"values": []}, {"values": ["org-1"]}],
+					"prerequisites": [{}]
+				}
+			}
+		}
+	]
+}`
+
+func TestParseFlags(t *testing.T) {
+	flags, err := parseFlags([]byte(flagsResponse))
+
+	require.NoError(t, err)
+	require.Len(t, flags, 1)
+	f := flags[0]
+	assert.Equal(t, []string{"true", "Disabled"}, f.variations)
+	require.Len(t, f.environments, 2)
+	assert.Equal(t, "production", f.environments[0].key)
+	assert.Equal(t, "1 prerequisite, 3 targets, 2 rules", f.environments[0].targetingSummary())
+	assert.Equal(t, "default rule serves true, off serves Disabled", f.environments[0].fallthroughSummary(f.variations))
+	assert.Equal(t, "test", f.environments[1].key)
+	assert.Equal(t, "0 targets, 0 rules", f.environments[1].targetingSummary())
+	assert.Equal(t, "default rule serves percentage rollout, off serves Disabled", f.environments[1].fallthroughSummary(f.variations))
+}
+
+func TestToggle(t *testing.T) {
+	setup := func(t *testing.T, client flags.Client) Model {
+		t.Helper()
+		resourcesClient := &resources.MockClient{Response: []byte(flagsResponse)}
+		m := NewModel(resourcesClient, client, "test-token", "http://localhost", "test-proj").(Model)
+		msg := fetchFlags(resourcesClient, "test-token", "http://localhost", "test-proj")()
+		model, _ := m.Update(msg)
+
+		return model.(Model)
+	}
+	press := func(m Model, keys ...tea.KeyMsg) (Model, tea.Cmd) {
+		var model tea.Model = m
+		var cmd tea.Cmd
+		for _, k := range keys {
+			model, cmd = model.Update(k)
+		}
+
+		return model.(Model), cmd
+	}
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+	tab := tea.KeyMsg{Type: tea.KeyTab}
+	down := tea.KeyMsg{Type: tea.KeyDown}
+
+	t.Run("toggles the flag in the chosen environment after confirming", func(t *testing.T) {
+		client := &flags.MockClient{}
+		client.
+			On("Update", "test-token", "http://localhost", "test-proj", "test-flag", flags.BuildToggleFlagPatch("test", true)).
+			Return([]byte(`{"key": "test-flag", "environments": {"production": {"on": true}, "test": {"on": true}}}`), nil)
+		m := setup(t, client)
+
+		m, _ = press(m, enter, down, tab)
+		assert.Equal(t, focusConfirm, m.focus)
+		assert.Contains(t, m.View(), "Turn test-flag ON in Test?")
+
+		m, cmd := press(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+		require.True(t, m.toggling)
+		msg := toggleFlag(client, "test-token", "http://localhost", "test-proj", "test-flag", "test", true)()
+		model, _ := m.Update(msg)
+		m = model.(Model)
+
+		require.NotNil(t, cmd)
+		client.AssertExpectations(t)
+		assert.False(t, m.toggling)
+		assert.True(t, m.throttling)
+		f, env, ok := m.selectedEnvironment()
+		require.True(t, ok)
+		assert.Equal(t, "test-flag", f.key)
+		assert.True(t, env.on)
+	})
+
+	t.Run("doesn't toggle the flag when it's canceled", func(t *testing.T) {
+		client := &flags.MockClient{}
+		m := setup(t, client)
+
+		m, cmd := press(m, enter, tab, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+
+		assert.Nil(t, cmd)
+		assert.Equal(t, focusEnvironments, m.focus)
+		assert.False(t, m.toggling)
+		client.AssertNotCalled(t, "Update")
+	})
+
+	t.Run("doesn't toggle the flag again while throttled", func(t *testing.T) {
+		client := &flags.MockClient{}
+		m := setup(t, client)
+		m.throttling = true
+
+		m, _ = press(m, enter, tab)
+
+		assert.Equal(t, focusEnvironments, m.focus)
+	})
+}
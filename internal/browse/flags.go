@@ -0,0 +1,186 @@
+package browse
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// flag is a feature flag with a summary of its targeting in each environment.
+type flag struct {
+	description  string
+	environments []flagEnvironment
+	key          string
+	kind         string
+	name         string
+	tags         []string
+	variations   []string
+}
+
+func (f flag) FilterValue() string { return f.key + " " + f.name }
+
+type flagEnvironment struct {
+	fallthroughVariation *int
+	key                  string
+	name                 string
+	offVariation         *int
+	on                   bool
+	prerequisites        int
+	rollout              bool
+	rules                int
+	targets              int
+}
+
+type flagJSON struct {
+	Description  string                         `json:"description"`
+	Environments map[string]flagEnvironmentJSON `json:"environments"`
+	Key          string                         `json:"key"`
+	Kind         string                         `json:"kind"`
+	Name         string                         `json:"name"`
+	Tags         []string                       `json:"tags"`
+	Variations   []struct {
+		Name  *string     `json:"name"`
+		Value interface{} `json:"value"`
+	} `json:"variations"`
+}
+
+type flagEnvironmentJSON struct {
+	ContextTargets []targetJSON `json:"contextTargets"`
+	Fallthrough    *struct {
+		Rollout   interface{} `json:"rollout"`
+		Variation *int        `json:"variation"`
+	} `json:"fallthrough"`
+	Name          string        `json:"_environmentName"`
+	OffVariation  *int          `json:"offVariation"`
+	On            bool          `json:"on"`
+	Prerequisites []interface{} `json:"prerequisites"`
+	Rules         []interface{} `json:"rules"`
+	Targets       []targetJSON  `json:"targets"`
+}
+
+type targetJSON struct {
+	Values []string `json:"values"`
+}
+
+// parseFlags gets the flags from a response with a list of flags.
+func parseFlags(data []byte) ([]flag, error) {
+	var resp struct {
+		Items []flagJSON `json:"items"`
+	}
+	err := json.Unmarshal(data, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	flags := make([]flag, 0, len(resp.Items))
+	for _, f := range resp.Items {
+		flags = append(flags, newFlag(f))
+	}
+
+	return flags, nil
+}
+
+// parseFlag gets the flag from a response with one flag.
+func parseFlag(data []byte) (flag, error) {
+	var f flagJSON
+	err := json.Unmarshal(data, &f)
+	if err != nil {
+		return flag{}, err
+	}
+
+	return newFlag(f), nil
+}
+
+func newFlag(f flagJSON) flag {
+	variations := make([]string, 0, len(f.Variations))
+	for _, v := range f.Variations {
+		if v.Name != nil && *v.Name != "" {
+			variations = append(variations, *v.Name)
+			continue
+		}
+		value, _ := json.Marshal(v.Value)
+		variations = append(variations, string(value))
+	}
+
+	environments := make([]flagEnvironment, 0, len(f.Environments))
+	for key, e := range f.Environments {
+		env := flagEnvironment{
+			key:           key,
+			name:          e.Name,
+			offVariation:  e.OffVariation,
+			on:            e.On,
+			prerequisites: len(e.Prerequisites),
+			rules:         len(e.Rules),
+		}
+		if env.name == "" {
+			env.name = key
+		}
+		if e.Fallthrough != nil {
+			env.fallthroughVariation = e.Fallthrough.Variation
+			env.rollout = e.Fallthrough.Rollout != nil
+		}
+		// targets for the user context kind are in both lists, but only one of them has the values
+		for _, t := range append(e.Targets, e.ContextTargets...) {
+			env.targets += len(t.Values)
+		}
+		environments = append(environments, env)
+	}
+	sort.Slice(environments, func(i, j int) bool {
+		return environments[i].name < environments[j].name
+	})
+
+	return flag{
+		description:  f.Description,
+		environments: environments,
+		key:          f.Key,
+		kind:         f.Kind,
+		name:         f.Name,
+		tags:         f.Tags,
+		variations:   variations,
+	}
+}
+
+// targetingSummary describes who is targeted in the environment, such as "2 rules, 5 targets".
+func (e flagEnvironment) targetingSummary() string {
+	parts := make([]string, 0, 3)
+	if e.prerequisites > 0 {
+		parts = append(parts, plural(e.prerequisites, "prerequisite"))
+	}
+	parts = append(parts, plural(e.targets, "target"), plural(e.rules, "rule"))
+
+	return strings.Join(parts, ", ")
+}
+
+// fallthroughSummary describes what contexts that aren't targeted get when the flag is on and off.
+func (e flagEnvironment) fallthroughSummary(variations []string) string {
+	defaultRule := "none"
+	switch {
+	case e.rollout:
+		defaultRule = "percentage rollout"
+	case e.fallthroughVariation != nil:
+		defaultRule = variationName(variations, *e.fallthroughVariation)
+	}
+	offVariation := "none"
+	if e.offVariation != nil {
+		offVariation = variationName(variations, *e.offVariation)
+	}
+
+	return fmt.Sprintf("default rule serves %s, off serves %s", defaultRule, offVariation)
+}
+
+func variationName(variations []string, index int) string {
+	if index < 0 || index >= len(variations) {
+		return fmt.Sprintf("variation %d", index)
+	}
+
+	return variations[index]
+}
+
+func plural(count int, noun string) string {
+	if count == 1 {
+		return fmt.Sprintf("%d %s", count, noun)
+	}
+
+	return fmt.Sprintf("%d %ss", count, noun)
+}
@@ -0,0 +1,119 @@
+package browse
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+)
+
+var (
+	BindingBack = key.NewBinding(
+		key.WithKeys("esc"),
+		key.WithHelp("esc", "back"),
+	)
+	BindingCancel = key.NewBinding(
+		key.WithKeys("n", "esc"),
+		key.WithHelp("n/esc", "cancel"),
+	)
+	BindingCloseFullHelp = key.NewBinding(
+		key.WithKeys("?"),
+		key.WithHelp("?", "close help"),
+	)
+	BindingConfirm = key.NewBinding(
+		key.WithKeys("y"),
+		key.WithHelp("y", "confirm"),
+	)
+	BindingCursorDown = key.NewBinding(
+		key.WithKeys("down", "j"),
+		key.WithHelp("↓/j", "down"),
+	)
+	BindingCursorUp = key.NewBinding(
+		key.WithKeys("up", "k"),
+		key.WithHelp("↑/k", "up"),
+	)
+	BindingFilter = key.NewBinding(
+		key.WithKeys("/"),
+		key.WithHelp("/", "filter"),
+	)
+	BindingQuit = key.NewBinding(
+		key.WithKeys("ctrl+c"),
+		key.WithHelp("ctrl+c", "quit"),
+	)
+	BindingSelect = key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "choose environment"),
+	)
+	BindingShowFullHelp = key.NewBinding(
+		key.WithKeys("?"),
+		key.WithHelp("?", "more"),
+	)
+	BindingToggle = key.NewBinding(
+		key.WithKeys("tab", " "),
+		key.WithHelp("tab/space", "toggle"),
+	)
+)
+
+// keyMap defines all the possible key presses we would respond to
+type keyMap struct {
+	Back          key.Binding
+	Cancel        key.Binding
+	CloseFullHelp key.Binding
+	Confirm       key.Binding
+	CursorDown    key.Binding
+	CursorUp      key.Binding
+	Filter        key.Binding
+	Quit          key.Binding
+	Select        key.Binding
+	ShowFullHelp  key.Binding
+	Toggle        key.Binding
+}
+
+func (k keyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.CursorUp, k.CursorDown, k.Filter, k.Select, k.Toggle},
+		{k.Confirm, k.Cancel, k.Back, k.Quit, k.CloseFullHelp},
+	}
+}
+
+func (k keyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Filter, k.Select, k.Toggle, k.Confirm, k.Cancel, k.Back, k.Quit, k.ShowFullHelp}
+}
+
+// listKeys are the keys shown while choosing a flag.
+var listKeys = keyMap{
+	CursorDown:    BindingCursorDown,
+	CursorUp:      BindingCursorUp,
+	CloseFullHelp: BindingCloseFullHelp,
+	Filter:        BindingFilter,
+	Quit:          BindingQuit,
+	Select:        BindingSelect,
+	ShowFullHelp:  BindingShowFullHelp,
+}
+
+// environmentKeys are the keys shown while choosing an environment to toggle the flag in.
+var environmentKeys = keyMap{
+	Back:          BindingBack,
+	CursorDown:    BindingCursorDown,
+	CursorUp:      BindingCursorUp,
+	CloseFullHelp: BindingCloseFullHelp,
+	Quit:          BindingQuit,
+	ShowFullHelp:  BindingShowFullHelp,
+	Toggle:        BindingToggle,
+}
+
+// confirmKeys are the keys shown while confirming a toggle.
+var confirmKeys = keyMap{
+	Cancel:  BindingCancel,
+	Confirm: BindingConfirm,
+	Quit:    BindingQuit,
+}
+
+// footerView shows any error messages and help text.
+func footerView(helpView string, err error) string {
+	var errView string
+	spacer := "\n\n"
+	if err != nil {
+		spacer = "\n"
+		errView = "\n" + err.Error()
+	}
+
+	return errView + spacer + helpView
+}
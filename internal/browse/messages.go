@@ -0,0 +1,91 @@
+package browse
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"ldcli/internal/errors"
+	"ldcli/internal/flags"
+	"ldcli/internal/resources"
+)
+
+// errMsg is sent when a request fails.
+type errMsg struct {
+	err error
+}
+
+type fetchedFlagsMsg struct {
+	flags []flag
+}
+
+func fetchFlags(client resources.Client, accessToken, baseURI, projKey string) tea.Cmd {
+	return func() tea.Msg {
+		items, err := flags.ListFlags(client, accessToken, baseURI, projKey)
+		if err != nil {
+			return errMsg{err: requestError(err)}
+		}
+
+		response, err := json.Marshal(map[string]interface{}{"items": items})
+		if err != nil {
+			return errMsg{err: err}
+		}
+		flags, err := parseFlags(response)
+		if err != nil {
+			return errMsg{err: err}
+		}
+
+		return fetchedFlagsMsg{flags: flags}
+	}
+}
+
+type toggledFlagMsg struct {
+	flag flag
+}
+
+func toggleFlag(client flags.Client, accessToken, baseURI, projKey, flagKey, envKey string, on bool) tea.Cmd {
+	return func() tea.Msg {
+		response, err := client.Update(
+			context.Background(),
+			accessToken,
+			baseURI,
+			flagKey,
+			projKey,
+			flags.BuildToggleFlagPatch(envKey, on),
+		)
+		if err != nil {
+			return errMsg{err: requestError(err)}
+		}
+
+		flag, err := parseFlag(response)
+		if err != nil {
+			return errMsg{err: err}
+		}
+
+		return toggledFlagMsg{flag: flag}
+	}
+}
+
+type flagToggleThrottleMsg struct{}
+
+// throttleFlagToggle waits before allowing another toggle so holding down a key doesn't send
+// a request for each key press.
+func throttleFlagToggle() tea.Cmd {
+	return tea.Tick(throttleDuration, func(_ time.Time) tea.Msg {
+		return flagToggleThrottleMsg{}
+	})
+}
+
+// requestError gets the message from an API error response.
+func requestError(err error) error {
+	var e struct {
+		Message string `json:"message"`
+	}
+	if jsonErr := json.Unmarshal([]byte(err.Error()), &e); jsonErr != nil || e.Message == "" {
+		return err
+	}
+
+	return errors.NewError(e.Message)
+}
@@ -2,8 +2,11 @@ package client
 
 import (
 	"fmt"
+	"net/http"
 
 	ldapi "github.com/launchdarkly/api-client-go/v14"
+
+	"ldcli/internal/debug"
 )
 
 // New creates an LD API client. It's not set as a field on the struct because the CLI flags
@@ -14,6 +17,9 @@ func New(accessToken string, baseURI string, cliVersion string) *ldapi.APIClient
 	config.AddDefaultHeader("Authorization", accessToken)
 	config.UserAgent = fmt.Sprintf("launchdarkly-cli/v%s", cliVersion)
 	config.Servers[0].URL = baseURI
+	config.HTTPClient = &http.Client{
+		Transport: debug.NewTransport(http.DefaultTransport),
+	}
 
 	return ldapi.NewAPIClient(config)
 }
@@ -0,0 +1,139 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+
+	"ldcli/cmd/cliflags"
+)
+
+// SetAccessToken saves the access token for the profile to its credential store, or to the config
+// file if the profile doesn't use one.
+func SetAccessToken(configFile string, profile string, token string) error {
+	rawConfig, err := readRawConfig(configFile)
+	if err != nil {
+		return err
+	}
+
+	profileConfig, _ := Profile(rawConfig, profile, true)
+	profileConfig[cliflags.AccessTokenFlag] = token
+	err = SaveAccessToken(profileConfig, profile, configFile)
+	if err != nil {
+		return err
+	}
+
+	return writeRawConfig(configFile, rawConfig)
+}
+
+// UnsetAccessToken removes the access token for the profile from its credential store and the
+// config file.
+func UnsetAccessToken(configFile string, profile string) error {
+	rawConfig, err := readRawConfig(configFile)
+	if err != nil {
+		return err
+	}
+
+	profileConfig, ok := Profile(rawConfig, profile, false)
+	if !ok {
+		return nil
+	}
+	err = DeleteAccessToken(profileConfig, profile, configFile)
+	if err != nil {
+		return err
+	}
+
+	return writeRawConfig(configFile, rawConfig)
+}
+
+// SaveAccessToken moves the access token from the profile's settings to its credential store when
+// the profile uses one.
+func SaveAccessToken(profileConfig map[string]interface{}, profile string, configFile string) error {
+	accessToken, ok := profileConfig[cliflags.AccessTokenFlag]
+	if !ok {
+		return nil
+	}
+
+	conf, err := NewConfig(profileConfig)
+	if err != nil {
+		return err
+	}
+	store, ok := NewCredentialStore(conf, configFile)
+	if !ok {
+		return nil
+	}
+	err = store.Set(profileOrDefault(profile), fmt.Sprintf("%v", accessToken))
+	if err != nil {
+		return err
+	}
+	delete(profileConfig, cliflags.AccessTokenFlag)
+
+	return nil
+}
+
+// DeleteAccessToken removes the access token from the profile's settings and its credential store
+// when the profile uses one.
+func DeleteAccessToken(profileConfig map[string]interface{}, profile string, configFile string) error {
+	delete(profileConfig, cliflags.AccessTokenFlag)
+
+	conf, err := NewConfig(profileConfig)
+	if err != nil {
+		return err
+	}
+	store, ok := NewCredentialStore(conf, configFile)
+	if !ok {
+		return nil
+	}
+	err = store.Delete(profileOrDefault(profile))
+	if err != nil && !errors.Is(err, ErrCredentialNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+func profileOrDefault(profile string) string {
+	if profile == "" {
+		return DefaultProfile
+	}
+
+	return profile
+}
+
+func readRawConfig(configFile string) (map[string]interface{}, error) {
+	rawConfig := make(map[string]interface{})
+	data, err := os.ReadFile(configFile)
+	if os.IsNotExist(err) {
+		return rawConfig, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = yaml.Unmarshal(data, &rawConfig)
+	if err != nil {
+		return nil, err
+	}
+	if rawConfig == nil {
+		rawConfig = make(map[string]interface{})
+	}
+
+	return rawConfig, nil
+}
+
+func writeRawConfig(configFile string, rawConfig map[string]interface{}) error {
+	data, err := yaml.Marshal(rawConfig)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(configFile), 0700)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(configFile, data, 0600)
+}
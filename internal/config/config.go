@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
+	"time"
 
 	"github.com/mitchellh/go-homedir"
 
@@ -13,52 +15,248 @@ import (
 	"ldcli/internal/output"
 )
 
-const Filename = ".ldcli-config.yml"
+const (
+	// Filename is the name of a project's local config file, which ldcli looks for in the current
+	// directory and its parents.
+	Filename = ".ldcli.yml"
+
+	// DefaultProfile is the name of the profile with the settings at the top level of the config
+	// file.
+	DefaultProfile = "default"
+	profilesKey    = "profiles"
+)
 
 // ConfigFile represents the data stored in the config file.
 type ConfigFile struct {
-	AccessToken     string `json:"access-token,omitempty" yaml:"access-token,omitempty"`
-	AnalyticsOptOut *bool  `json:"analytics-opt-out,omitempty" yaml:"analytics-opt-out,omitempty"`
-	BaseURI         string `json:"base-uri,omitempty" yaml:"base-uri,omitempty"`
-	Output          string `json:"output,omitempty" yaml:"output,omitempty"`
+	AccessToken      string `json:"access-token,omitempty" yaml:"access-token,omitempty"`
+	AnalyticsOptOut  *bool  `json:"analytics-opt-out,omitempty" yaml:"analytics-opt-out,omitempty"`
+	BaseURI          string `json:"base-uri,omitempty" yaml:"base-uri,omitempty"`
+	CredentialHelper string `json:"credential-helper,omitempty" yaml:"credential-helper,omitempty"`
+	CredentialStore  string `json:"credential-store,omitempty" yaml:"credential-store,omitempty"`
+	Environment      string `json:"environment,omitempty" yaml:"environment,omitempty"`
+	MaxRetries       *int   `json:"max-retries,omitempty" yaml:"max-retries,omitempty"`
+	Output           string `json:"output,omitempty" yaml:"output,omitempty"`
+	Project          string `json:"project,omitempty" yaml:"project,omitempty"`
+	Timeout          string `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+
+	Profiles map[string]ConfigFile `json:"profiles,omitempty" yaml:"profiles,omitempty"`
+	// Unknown has the keys that aren't supported settings so they're kept when the file is written.
+	Unknown map[string]interface{} `json:"-" yaml:",inline"`
 }
 
 func NewConfig(rawConfig map[string]interface{}) (ConfigFile, error) {
 	var (
-		accessToken     string
-		analyticsOptOut bool
-		baseURI         string
-		err             error
-		outputKind      output.OutputKind
+		accessToken      string
+		analyticsOptOut  *bool
+		baseURI          string
+		credentialHelper string
+		credentialStore  string
+		environment      string
+		err              error
+		maxRetries       *int
+		outputKind       output.OutputKind
+		project          string
+		timeout          string
 	)
 	if rawConfig[cliflags.AccessTokenFlag] != nil {
-		accessToken = rawConfig[cliflags.AccessTokenFlag].(string)
+		accessToken, err = stringSetting(rawConfig, cliflags.AccessTokenFlag)
+		if err != nil {
+			return ConfigFile{}, err
+		}
 	}
 	if rawConfig[cliflags.AnalyticsOptOut] != nil {
 		stringValue := fmt.Sprintf("%v", rawConfig[cliflags.AnalyticsOptOut])
-		analyticsOptOut, err = strconv.ParseBool(stringValue)
+		value, err := strconv.ParseBool(stringValue)
 		if err != nil {
 			return ConfigFile{}, errors.NewError("analytics-opt-out must be true or false")
 		}
+		analyticsOptOut = &value
 	}
 	if rawConfig[cliflags.BaseURIFlag] != nil {
-		baseURI = rawConfig[cliflags.BaseURIFlag].(string)
+		baseURI, err = stringSetting(rawConfig, cliflags.BaseURIFlag)
+		if err != nil {
+			return ConfigFile{}, err
+		}
+	}
+	if rawConfig[cliflags.CredentialHelper] != nil {
+		credentialHelper, err = stringSetting(rawConfig, cliflags.CredentialHelper)
+		if err != nil {
+			return ConfigFile{}, err
+		}
+	}
+	if rawConfig[cliflags.CredentialStore] != nil {
+		credentialStore, err = stringSetting(rawConfig, cliflags.CredentialStore)
+		if err != nil {
+			return ConfigFile{}, err
+		}
+		switch credentialStore {
+		case CredentialStoreConfig, CredentialStoreFile, CredentialStoreKeyring:
+		default:
+			return ConfigFile{}, errors.NewError("credential-store must be config, file, or keyring")
+		}
+	}
+	if rawConfig[cliflags.EnvironmentFlag] != nil {
+		environment, err = stringSetting(rawConfig, cliflags.EnvironmentFlag)
+		if err != nil {
+			return ConfigFile{}, err
+		}
+	}
+	if rawConfig[cliflags.MaxRetriesFlag] != nil {
+		stringValue := fmt.Sprintf("%v", rawConfig[cliflags.MaxRetriesFlag])
+		value, err := strconv.Atoi(stringValue)
+		if err != nil || value < 0 {
+			return ConfigFile{}, errors.NewError("max-retries must be a number greater than or equal to 0")
+		}
+		maxRetries = &value
 	}
 	if rawConfig[cliflags.OutputFlag] != nil {
-		outputKind, err = output.NewOutputKind(rawConfig[cliflags.OutputFlag].(string))
+		value, err := stringSetting(rawConfig, cliflags.OutputFlag)
+		if err != nil {
+			return ConfigFile{}, err
+		}
+		outputKind, err = output.NewOutputKind(value)
+		if err != nil {
+			return ConfigFile{}, err
+		}
+	}
+	if rawConfig[cliflags.ProjectFlag] != nil {
+		project, err = stringSetting(rawConfig, cliflags.ProjectFlag)
+		if err != nil {
+			return ConfigFile{}, err
+		}
+	}
+	if rawConfig[cliflags.TimeoutFlag] != nil {
+		timeout, err = stringSetting(rawConfig, cliflags.TimeoutFlag)
 		if err != nil {
 			return ConfigFile{}, err
 		}
+		value, err := time.ParseDuration(timeout)
+		if err != nil || value < 0 {
+			return ConfigFile{}, errors.NewError("timeout must be a duration such as 30s or 2m")
+		}
+	}
+
+	var profiles map[string]ConfigFile
+	if rawConfig[profilesKey] != nil {
+		rawProfiles, ok := rawConfig[profilesKey].(map[string]interface{})
+		if !ok {
+			return ConfigFile{}, errors.NewError("profiles must be a map of profile names to settings")
+		}
+		profiles = make(map[string]ConfigFile, len(rawProfiles))
+		for name, rawProfile := range rawProfiles {
+			profileConfig, ok := rawProfile.(map[string]interface{})
+			if !ok && rawProfile != nil {
+				return ConfigFile{}, errors.NewError(fmt.Sprintf("profile %s must be a map of settings", name))
+			}
+			if profileConfig[profilesKey] != nil {
+				return ConfigFile{}, errors.NewError(fmt.Sprintf("profile %s can't have its own profiles", name))
+			}
+			profiles[name], err = NewConfig(profileConfig)
+			if err != nil {
+				return ConfigFile{}, err
+			}
+		}
+	}
+
+	var unknown map[string]interface{}
+	for key, value := range rawConfig {
+		if !IsSetting(key) && key != profilesKey {
+			if unknown == nil {
+				unknown = make(map[string]interface{})
+			}
+			unknown[key] = value
+		}
 	}
 
 	return ConfigFile{
-		AccessToken:     accessToken,
-		AnalyticsOptOut: &analyticsOptOut,
-		BaseURI:         baseURI,
-		Output:          outputKind.String(),
+		AccessToken:      accessToken,
+		AnalyticsOptOut:  analyticsOptOut,
+		BaseURI:          baseURI,
+		CredentialHelper: credentialHelper,
+		CredentialStore:  credentialStore,
+		Environment:      environment,
+		MaxRetries:       maxRetries,
+		Output:           outputKind.String(),
+		Project:          project,
+		Timeout:          timeout,
+		Profiles:         profiles,
+		Unknown:          unknown,
 	}, nil
 }
 
+// IsSetting checks if the key is a setting the CLI supports.
+func IsSetting(key string) bool {
+	_, ok := cliflags.AllFlagsHelp()[key]
+
+	return ok
+}
+
+// UnknownSettings gets the keys in the config file data that aren't settings the CLI supports,
+// such as a misspelled setting. Keys in a profile are prefixed with the profile name.
+func (c ConfigFile) UnknownSettings() []string {
+	keys := make([]string, 0)
+	for key := range c.Unknown {
+		keys = append(keys, key)
+	}
+	for name, profile := range c.Profiles {
+		for key := range profile.Unknown {
+			keys = append(keys, fmt.Sprintf("%s.%s.%s", profilesKey, name, key))
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func stringSetting(rawConfig map[string]interface{}, key string) (string, error) {
+	value, ok := rawConfig[key].(string)
+	if !ok {
+		return "", errors.NewError(fmt.Sprintf("%s must be a string", key))
+	}
+
+	return value, nil
+}
+
+// Profile gets the settings for the profile from the raw config file data. If create is true, it
+// adds the profile when it doesn't exist, so its settings can be changed.
+func Profile(rawConfig map[string]interface{}, name string, create bool) (map[string]interface{}, bool) {
+	if name == "" || name == DefaultProfile {
+		return rawConfig, true
+	}
+
+	profiles, ok := rawConfig[profilesKey].(map[string]interface{})
+	if !ok {
+		if !create {
+			return nil, false
+		}
+		profiles = make(map[string]interface{})
+		rawConfig[profilesKey] = profiles
+	}
+	profile, ok := profiles[name].(map[string]interface{})
+	if !ok {
+		if !create {
+			return nil, false
+		}
+		profile = make(map[string]interface{})
+		profiles[name] = profile
+	}
+
+	return profile, true
+}
+
+// SharedSettings gets the settings at the top level of the raw config file data that also apply to
+// every profile. The access token isn't shared since each profile can be for a different account.
+func SharedSettings(rawConfig map[string]interface{}) map[string]interface{} {
+	settings := make(map[string]interface{}, len(rawConfig))
+	for key, value := range rawConfig {
+		if key != profilesKey && key != cliflags.AccessTokenFlag {
+			settings[key] = value
+		}
+	}
+
+	return settings
+}
+
 // GetConfigFile gets the full path to the config file.
 func GetConfigFile() string {
 	configPath := os.Getenv("XDG_CONFIG_HOME")
@@ -47,6 +47,13 @@ func TestNewConfig(t *testing.T) {
 		}
 	})
 
+	t.Run("analytics-opt-out isn't set when it's missing", func(t *testing.T) {
+		configFile, err := config.NewConfig(map[string]interface{}{})
+
+		require.NoError(t, err)
+		assert.Nil(t, configFile.AnalyticsOptOut)
+	})
+
 	t.Run("is an error when analytics-opt-out is something else", func(t *testing.T) {
 		rawConfig := map[string]interface{}{
 			"analytics-opt-out": "something",
@@ -67,6 +74,9 @@ func TestNewConfig(t *testing.T) {
 			"is valid when value is plaintext": {
 				input: "json",
 			},
+			"is valid when value is yaml": {
+				input: "yaml",
+			},
 		}
 		for name, tt := range tests {
 			tt := tt
@@ -92,4 +102,169 @@ func TestNewConfig(t *testing.T) {
 
 		assert.EqualError(t, err, "output is invalid")
 	})
+	t.Run("max-retries", func(t *testing.T) {
+		rawConfig := map[string]interface{}{
+			"max-retries": "5",
+		}
+
+		configFile, err := config.NewConfig(rawConfig)
+
+		require.NoError(t, err)
+		assert.Equal(t, 5, *configFile.MaxRetries)
+	})
+
+	t.Run("is invalid with a negative max-retries", func(t *testing.T) {
+		rawConfig := map[string]interface{}{
+			"max-retries": -1,
+		}
+
+		_, err := config.NewConfig(rawConfig)
+
+		assert.EqualError(t, err, "max-retries must be a number greater than or equal to 0")
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		rawConfig := map[string]interface{}{
+			"timeout": "2m",
+		}
+
+		configFile, err := config.NewConfig(rawConfig)
+
+		require.NoError(t, err)
+		assert.Equal(t, "2m", configFile.Timeout)
+	})
+
+	t.Run("is invalid with an invalid timeout", func(t *testing.T) {
+		rawConfig := map[string]interface{}{
+			"timeout": "soon",
+		}
+
+		_, err := config.NewConfig(rawConfig)
+
+		assert.EqualError(t, err, "timeout must be a duration such as 30s or 2m")
+	})
+	t.Run("with profiles", func(t *testing.T) {
+		rawConfig := map[string]interface{}{
+			"access-token": "prod-token",
+			"profiles": map[string]interface{}{
+				"fed": map[string]interface{}{
+					"access-token": "fed-token",
+					"base-uri":     "https://app.launchdarkly.us",
+				},
+			},
+		}
+
+		configFile, err := config.NewConfig(rawConfig)
+
+		require.NoError(t, err)
+		assert.Equal(t, "prod-token", configFile.AccessToken)
+		assert.Equal(t, "fed-token", configFile.Profiles["fed"].AccessToken)
+		assert.Equal(t, "https://app.launchdarkly.us", configFile.Profiles["fed"].BaseURI)
+	})
+
+	t.Run("is invalid with an invalid setting in a profile", func(t *testing.T) {
+		rawConfig := map[string]interface{}{
+			"profiles": map[string]interface{}{
+				"fed": map[string]interface{}{
+					"output": "invalid",
+				},
+			},
+		}
+
+		_, err := config.NewConfig(rawConfig)
+
+		assert.EqualError(t, err, "output is invalid")
+	})
+	t.Run("with a default project and environment", func(t *testing.T) {
+		rawConfig := map[string]interface{}{
+			"environment": "production",
+			"project":     "default",
+		}
+
+		configFile, err := config.NewConfig(rawConfig)
+
+		require.NoError(t, err)
+		assert.Equal(t, "production", configFile.Environment)
+		assert.Equal(t, "default", configFile.Project)
+	})
+
+	t.Run("is invalid with a setting that isn't a string", func(t *testing.T) {
+		rawConfig := map[string]interface{}{
+			"access-token": []interface{}{"test-token"},
+		}
+
+		_, err := config.NewConfig(rawConfig)
+
+		assert.EqualError(t, err, "access-token must be a string")
+	})
+
+	t.Run("keeps unknown settings", func(t *testing.T) {
+		rawConfig := map[string]interface{}{
+			"acess-token": "test-token",
+			"profiles": map[string]interface{}{
+				"fed": map[string]interface{}{
+					"projcet": "default",
+				},
+			},
+		}
+
+		configFile, err := config.NewConfig(rawConfig)
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"acess-token": "test-token"}, configFile.Unknown)
+		assert.Equal(t, []string{"acess-token", "profiles.fed.projcet"}, configFile.UnknownSettings())
+	})
+
+	t.Run("is invalid with an invalid credential-store", func(t *testing.T) {
+		rawConfig := map[string]interface{}{
+			"credential-store": "vault",
+		}
+
+		_, err := config.NewConfig(rawConfig)
+
+		assert.EqualError(t, err, "credential-store must be config, file, or keyring")
+	})
+}
+
+func TestProfile(t *testing.T) {
+	rawConfig := map[string]interface{}{
+		"access-token": "prod-token",
+		"profiles": map[string]interface{}{
+			"fed": map[string]interface{}{
+				"access-token": "fed-token",
+			},
+		},
+	}
+
+	t.Run("gets the top-level settings for the default profile", func(t *testing.T) {
+		profile, ok := config.Profile(rawConfig, config.DefaultProfile, false)
+
+		require.True(t, ok)
+		assert.Equal(t, "prod-token", profile["access-token"])
+	})
+
+	t.Run("gets a named profile", func(t *testing.T) {
+		profile, ok := config.Profile(rawConfig, "fed", false)
+
+		require.True(t, ok)
+		assert.Equal(t, map[string]interface{}{"access-token": "fed-token"}, profile)
+	})
+
+	t.Run("without a profile", func(t *testing.T) {
+		_, ok := config.Profile(rawConfig, "staging", false)
+
+		assert.False(t, ok)
+	})
+
+	t.Run("creates a profile", func(t *testing.T) {
+		profile, ok := config.Profile(rawConfig, "staging", true)
+		profile["base-uri"] = "https://staging.launchdarkly.com"
+
+		require.True(t, ok)
+		assert.Equal(
+			t,
+			map[string]interface{}{"base-uri": "https://staging.launchdarkly.com"},
+			rawConfig["profiles"].(map[string]interface{})["staging"],
+		)
+	})
 }
@@ -0,0 +1,343 @@
+package config
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	// CredentialStoreConfig keeps the access token in the config file.
+	CredentialStoreConfig = "config"
+	// CredentialStoreFile keeps the access token in a file encrypted with the password from the
+	// LD_KEYRING_PASSWORD environment variable.
+	CredentialStoreFile = "file"
+	// CredentialStoreKeyring keeps the access token in the OS keyring, or in the encrypted file if
+	// there isn't a keyring, such as on a headless machine.
+	CredentialStoreKeyring = "keyring"
+
+	CredentialsFilename     = "credentials"
+	KeyringPasswordEnvVar   = "LD_KEYRING_PASSWORD"
+	keyringService          = "ldcli"
+	keyDerivationIterations = 600000
+	saltSize                = 16
+)
+
+var ErrCredentialNotFound = errors.New("credential not found")
+
+// CredentialStore keeps access tokens outside of the config file, by profile name.
+type CredentialStore interface {
+	Get(profile string) (string, error)
+	Set(profile string, token string) error
+	Delete(profile string) error
+}
+
+// NewCredentialStore gets the store for the access token based on the config settings. It returns
+// false if the access token belongs in the config file.
+func NewCredentialStore(conf ConfigFile, configFile string) (CredentialStore, bool) {
+	if conf.CredentialHelper != "" {
+		return NewHelperStore(conf.CredentialHelper), true
+	}
+
+	credentialsFile := filepath.Join(filepath.Dir(configFile), CredentialsFilename)
+	switch conf.CredentialStore {
+	case CredentialStoreFile:
+		return NewFileStore(credentialsFile, os.Getenv(KeyringPasswordEnvVar)), true
+	case CredentialStoreKeyring:
+		if hasSecretService() {
+			return NewSecretServiceStore(), true
+		}
+
+		return NewFileStore(credentialsFile, os.Getenv(KeyringPasswordEnvVar)), true
+	default:
+		return nil, false
+	}
+}
+
+// HelperStore runs an external command to get the access token, such as a password manager's
+// CLI. The command gets the profile name from the LD_PROFILE environment variable and writes the
+// token to stdout.
+type HelperStore struct {
+	command string
+}
+
+var _ CredentialStore = HelperStore{}
+
+func NewHelperStore(command string) HelperStore {
+	return HelperStore{
+		command: command,
+	}
+}
+
+func (s HelperStore) Get(profile string) (string, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", s.command)
+	} else {
+		cmd = exec.Command("sh", "-c", s.command)
+	}
+	cmd.Env = append(os.Environ(), "LD_PROFILE="+profile)
+	cmd.Stdin = os.Stdin
+	cmd.Stderr = os.Stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("credential-helper failed: %w", err)
+	}
+	token := strings.TrimSpace(string(out))
+	if token == "" {
+		return "", ErrCredentialNotFound
+	}
+
+	return token, nil
+}
+
+func (s HelperStore) Set(_ string, _ string) error {
+	return errors.New("the access token comes from credential-helper, so the CLI can't change it")
+}
+
+// Delete doesn't do anything since the credential helper owns the access token.
+func (s HelperStore) Delete(_ string) error {
+	return nil
+}
+
+// SecretServiceStore keeps the access token in the Secret Service keyring, such as GNOME Keyring
+// or KWallet, with the secret-tool command from libsecret.
+type SecretServiceStore struct{}
+
+var _ CredentialStore = SecretServiceStore{}
+
+func NewSecretServiceStore() SecretServiceStore {
+	return SecretServiceStore{}
+}
+
+func (s SecretServiceStore) Get(profile string) (string, error) {
+	out, err := exec.Command("secret-tool", "lookup", "service", keyringService, "profile", profile).Output()
+	if err != nil {
+		// secret-tool exits with 1 without output when there isn't a secret
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) == 0 {
+			return "", ErrCredentialNotFound
+		}
+
+		return "", fmt.Errorf("reading from keyring failed: %w", err)
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
+
+func (s SecretServiceStore) Set(profile string, token string) error {
+	cmd := exec.Command(
+		"secret-tool",
+		"store",
+		"--label", fmt.Sprintf("LaunchDarkly CLI access token (%s)", profile),
+		"service", keyringService,
+		"profile", profile,
+	)
+	cmd.Stdin = strings.NewReader(token)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("writing to keyring failed: %s", strings.TrimSpace(string(out)))
+	}
+
+	return nil
+}
+
+func (s SecretServiceStore) Delete(profile string) error {
+	out, err := exec.Command("secret-tool", "clear", "service", keyringService, "profile", profile).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("deleting from keyring failed: %s", strings.TrimSpace(string(out)))
+	}
+
+	return nil
+}
+
+// hasSecretService checks if secret-tool is installed and there's a session bus to reach the
+// keyring on, which headless machines and containers usually don't have.
+func hasSecretService() bool {
+	if runtime.GOOS != "linux" || os.Getenv("DBUS_SESSION_BUS_ADDRESS") == "" {
+		return false
+	}
+	_, err := exec.LookPath("secret-tool")
+
+	return err == nil
+}
+
+// FileStore keeps access tokens in a file encrypted with AES-GCM using a key derived from a
+// password. It's for machines without a keyring.
+type FileStore struct {
+	path     string
+	password string
+}
+
+var _ CredentialStore = FileStore{}
+
+// credentialsFile is the data in the encrypted credentials file. Each token is encrypted
+// separately with a key derived from the password and salt.
+type credentialsFile struct {
+	Salt        string            `json:"salt"`
+	Credentials map[string]string `json:"credentials"`
+}
+
+func NewFileStore(path string, password string) FileStore {
+	return FileStore{
+		path:     path,
+		password: password,
+	}
+}
+
+func (s FileStore) Get(profile string) (string, error) {
+	file, salt, err := s.read()
+	if err != nil {
+		return "", err
+	}
+	encrypted, ok := file.Credentials[profile]
+	if !ok {
+		return "", ErrCredentialNotFound
+	}
+
+	data, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return "", s.decryptErr()
+	}
+	gcm, err := newGCM(s.key(salt))
+	if err != nil {
+		return "", err
+	}
+	if len(data) < gcm.NonceSize() {
+		return "", s.decryptErr()
+	}
+	token, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], []byte(profile))
+	if err != nil {
+		return "", s.decryptErr()
+	}
+
+	return string(token), nil
+}
+
+func (s FileStore) Set(profile string, token string) error {
+	file, salt, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	gcm, err := newGCM(s.key(salt))
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	_, err = io.ReadFull(rand.Reader, nonce)
+	if err != nil {
+		return err
+	}
+	file.Credentials[profile] = base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte(token), []byte(profile)))
+
+	return s.write(file)
+}
+
+func (s FileStore) Delete(profile string) error {
+	file, _, err := s.read()
+	if err != nil {
+		return err
+	}
+	if _, ok := file.Credentials[profile]; !ok {
+		return ErrCredentialNotFound
+	}
+	delete(file.Credentials, profile)
+
+	return s.write(file)
+}
+
+// read gets the credentials file and the salt for the key to its tokens. It creates a new salt if
+// the file doesn't exist yet.
+func (s FileStore) read() (credentialsFile, []byte, error) {
+	if s.password == "" {
+		return credentialsFile{}, nil, fmt.Errorf(
+			"%s must be set to use the encrypted credentials file when there isn't a keyring",
+			KeyringPasswordEnvVar,
+		)
+	}
+
+	file := credentialsFile{
+		Credentials: make(map[string]string),
+	}
+	data, err := os.ReadFile(s.path)
+	switch {
+	case os.IsNotExist(err):
+		salt := make([]byte, saltSize)
+		_, err = io.ReadFull(rand.Reader, salt)
+		if err != nil {
+			return credentialsFile{}, nil, err
+		}
+		file.Salt = base64.StdEncoding.EncodeToString(salt)
+	case err != nil:
+		return credentialsFile{}, nil, err
+	default:
+		err = json.Unmarshal(data, &file)
+		if err != nil {
+			return credentialsFile{}, nil, fmt.Errorf("credentials file %s is invalid", s.path)
+		}
+		if file.Credentials == nil {
+			file.Credentials = make(map[string]string)
+		}
+	}
+
+	salt, err := base64.StdEncoding.DecodeString(file.Salt)
+	if err != nil || len(salt) == 0 {
+		return credentialsFile{}, nil, fmt.Errorf("credentials file %s is invalid", s.path)
+	}
+
+	return file, salt, nil
+}
+
+// key derives the key for the tokens from the password. It's only derived when a token is
+// encrypted or decrypted since it's slow on purpose.
+func (s FileStore) key(salt []byte) []byte {
+	return deriveKey([]byte(s.password), salt, keyDerivationIterations, 32)
+}
+
+func (s FileStore) write(file credentialsFile) error {
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(s.path), 0700)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(s.path, data, 0600)
+}
+
+func (s FileStore) decryptErr() error {
+	return fmt.Errorf("unable to decrypt credentials file %s. Check that %s is correct", s.path, KeyringPasswordEnvVar)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// deriveKey derives an encryption key from the password with PBKDF2 using HMAC-SHA256, as defined
+// in RFC 8018.
+func deriveKey(password []byte, salt []byte, iterations int, keyLen int) []byte {
+	return pbkdf2.Key(password, salt, iterations, keyLen, sha256.New)
+}
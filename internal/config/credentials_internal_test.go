@@ -0,0 +1,40 @@
+package config
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// the PBKDF2-HMAC-SHA256 test vectors from RFC 7914 section 11
+func TestDeriveKey(t *testing.T) {
+	tests := map[string]struct {
+		password   string
+		salt       string
+		iterations int
+		expected   string
+	}{
+		"with one iteration": {
+			password:   "passwd",
+			salt:       "salt",
+			iterations: 1,
+			expected: "55ac046e56e3089fec1691c22544b605f94185216dde0465e68b9d57c20dacbc" +
+				"49ca9cccf179b645991664b39d77ef317c71b845b1e30bd509112041d3a19783",
+		},
+		"with many iterations": {
+			password:   "Password",
+			salt:       "NaCl",
+			iterations: 80000,
+			expected: "4ddcd8f60b98be21830cee5ef22701f9641a4418d04c0414aeff08876b34ab56" +
+				"a1d425a1225833549adb841b51c9b3176a272bdebba1d078478f62b397f33c8d",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			key := deriveKey([]byte(tt.password), []byte(tt.salt), tt.iterations, 64)
+
+			assert.Equal(t, tt.expected, hex.EncodeToString(key))
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ldcli/internal/config"
+)
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials")
+	store := config.NewFileStore(path, "test-password")
+
+	t.Run("without a token", func(t *testing.T) {
+		_, err := store.Get("default")
+
+		assert.ErrorIs(t, err, config.ErrCredentialNotFound)
+	})
+
+	t.Run("sets and gets a token by profile", func(t *testing.T) {
+		require.NoError(t, store.Set("default", "prod-token"))
+		require.NoError(t, store.Set("fed", "fed-token"))
+
+		token, err := store.Get("default")
+		require.NoError(t, err)
+		assert.Equal(t, "prod-token", token)
+		token, err = store.Get("fed")
+		require.NoError(t, err)
+		assert.Equal(t, "fed-token", token)
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "prod-token")
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		if runtime.GOOS != "windows" {
+			assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+		}
+	})
+
+	t.Run("is an error with the wrong password", func(t *testing.T) {
+		_, err := config.NewFileStore(path, "wrong-password").Get("default")
+
+		assert.ErrorContains(t, err, "unable to decrypt credentials file")
+	})
+
+	t.Run("is an error without a password", func(t *testing.T) {
+		_, err := config.NewFileStore(path, "").Get("default")
+
+		assert.ErrorContains(t, err, "LD_KEYRING_PASSWORD must be set")
+	})
+
+	t.Run("deletes a token", func(t *testing.T) {
+		require.NoError(t, store.Delete("fed"))
+
+		_, err := store.Get("fed")
+		assert.ErrorIs(t, err, config.ErrCredentialNotFound)
+		assert.ErrorIs(t, store.Delete("fed"), config.ErrCredentialNotFound)
+	})
+}
+
+func TestHelperStore(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell")
+	}
+
+	t.Run("gets the token from the command's output", func(t *testing.T) {
+		token, err := config.NewHelperStore(`echo "token-$LD_PROFILE"`).Get("fed")
+
+		require.NoError(t, err)
+		assert.Equal(t, "token-fed", token)
+	})
+
+	t.Run("without output", func(t *testing.T) {
+		_, err := config.NewHelperStore("true").Get("default")
+
+		assert.ErrorIs(t, err, config.ErrCredentialNotFound)
+	})
+
+	t.Run("is an error when the command fails", func(t *testing.T) {
+		_, err := config.NewHelperStore("exit 1").Get("default")
+
+		assert.ErrorContains(t, err, "credential-helper failed")
+	})
+
+	t.Run("can't set a token", func(t *testing.T) {
+		err := config.NewHelperStore("true").Set("default", "token")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestNewCredentialStore(t *testing.T) {
+	t.Run("without a credential store", func(t *testing.T) {
+		_, ok := config.NewCredentialStore(config.ConfigFile{}, "config.yml")
+
+		assert.False(t, ok)
+	})
+
+	t.Run("prefers the credential helper", func(t *testing.T) {
+		store, ok := config.NewCredentialStore(
+			config.ConfigFile{
+				CredentialHelper: "echo token",
+				CredentialStore:  config.CredentialStoreFile,
+			},
+			"config.yml",
+		)
+
+		assert.True(t, ok)
+		assert.IsType(t, config.HelperStore{}, store)
+	})
+
+	t.Run("with an encrypted file", func(t *testing.T) {
+		store, ok := config.NewCredentialStore(
+			config.ConfigFile{
+				CredentialStore: config.CredentialStoreFile,
+			},
+			"config.yml",
+		)
+
+		assert.True(t, ok)
+		assert.IsType(t, config.FileStore{}, store)
+	})
+}
@@ -0,0 +1,81 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+
+	"ldcli/cmd/cliflags"
+	"ldcli/internal/errors"
+)
+
+// LocalSettings are the settings a project's local config file can set. Other settings, such as
+// the access token or base URI, only come from the user's config file so a repository can't
+// change where requests go or which credentials they use.
+var LocalSettings = []string{
+	cliflags.EnvironmentFlag,
+	cliflags.OutputFlag,
+	cliflags.ProjectFlag,
+}
+
+// FindLocalConfigFile looks for a local config file in the directory and then each of its parents.
+func FindLocalConfigFile(dir string) (string, bool) {
+	for {
+		path := filepath.Join(dir, Filename)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path, true
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+		dir = parent
+	}
+}
+
+// ReadLocalConfigFile gets the settings from a local config file along with the keys it ignores
+// because a local config file can't set them.
+func ReadLocalConfigFile(path string) (map[string]interface{}, []string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rawConfig := make(map[string]interface{})
+	err = yaml.Unmarshal(data, &rawConfig)
+	if err != nil {
+		return nil, nil, errors.NewError(fmt.Sprintf("%s is invalid: %s", path, err.Error()))
+	}
+
+	settings := make(map[string]interface{})
+	ignored := make([]string, 0)
+	for key, value := range rawConfig {
+		if !isLocalSetting(key) {
+			ignored = append(ignored, key)
+			continue
+		}
+		settings[key] = value
+	}
+	sort.Strings(ignored)
+
+	_, err = NewConfig(settings)
+	if err != nil {
+		return nil, nil, errors.NewError(fmt.Sprintf("%s is invalid: %s", path, err.Error()))
+	}
+
+	return settings, ignored, nil
+}
+
+func isLocalSetting(key string) bool {
+	for _, s := range LocalSettings {
+		if key == s {
+			return true
+		}
+	}
+
+	return false
+}
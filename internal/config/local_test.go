@@ -0,0 +1,66 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ldcli/internal/config"
+)
+
+func TestFindLocalConfigFile(t *testing.T) {
+	dir := t.TempDir()
+	repoDir := filepath.Join(dir, "repo")
+	subDir := filepath.Join(repoDir, "services", "api")
+	require.NoError(t, os.MkdirAll(subDir, 0700))
+
+	t.Run("without a local config file", func(t *testing.T) {
+		_, ok := config.FindLocalConfigFile(subDir)
+
+		assert.False(t, ok)
+	})
+
+	t.Run("finds the local config file in a parent directory", func(t *testing.T) {
+		path := filepath.Join(repoDir, config.Filename)
+		require.NoError(t, os.WriteFile(path, []byte("project: test-proj\n"), 0600))
+
+		found, ok := config.FindLocalConfigFile(subDir)
+
+		assert.True(t, ok)
+		assert.Equal(t, path, found)
+	})
+}
+
+func TestReadLocalConfigFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), config.Filename)
+
+	t.Run("gets the local settings and ignores others", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, []byte(`project: test-proj
+environment: staging
+output: json
+access-token: test-token
+base-uri: http://example.com
+`), 0600))
+
+		settings, ignored, err := config.ReadLocalConfigFile(path)
+
+		require.NoError(t, err)
+		assert.Equal(
+			t,
+			map[string]interface{}{"environment": "staging", "output": "json", "project": "test-proj"},
+			settings,
+		)
+		assert.Equal(t, []string{"access-token", "base-uri"}, ignored)
+	})
+
+	t.Run("is an error with an invalid setting", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, []byte("output: xml\n"), 0600))
+
+		_, _, err := config.ReadLocalConfigFile(path)
+
+		assert.EqualError(t, err, path+" is invalid: output is invalid")
+	})
+}
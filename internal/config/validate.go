@@ -0,0 +1,129 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+var yamlLinePattern = regexp.MustCompile(`^yaml: line (\d+): (.*)$`)
+
+// Problem is an invalid value or unknown setting in the config file and the line it's on.
+type Problem struct {
+	Line    int
+	Message string
+}
+
+func (p Problem) String() string {
+	if p.Line == 0 {
+		return p.Message
+	}
+
+	return fmt.Sprintf("line %d: %s", p.Line, p.Message)
+}
+
+// Validate checks every setting in the config file data. It returns errors for values the CLI
+// can't use and warnings for unknown settings, which the CLI ignores.
+func Validate(data []byte) ([]Problem, []Problem) {
+	var doc yaml.Node
+	err := yaml.Unmarshal(data, &doc)
+	if err != nil {
+		return []Problem{yamlProblem(err)}, nil
+	}
+	// an empty file doesn't have any settings
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+
+	return validateSettings(doc.Content[0], "", true)
+}
+
+func validateSettings(node *yaml.Node, profile string, allowProfiles bool) ([]Problem, []Problem) {
+	errs := make([]Problem, 0)
+	warnings := make([]Problem, 0)
+	if node.Kind != yaml.MappingNode {
+		message := "config file must be a map of settings"
+		if profile != "" {
+			message = fmt.Sprintf("profile %s must be a map of settings", profile)
+		}
+
+		return append(errs, Problem{Line: node.Line, Message: message}), warnings
+	}
+
+	lines := make(map[string]int)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keyNode, valueNode := node.Content[i], node.Content[i+1]
+		key := keyNode.Value
+		if line, ok := lines[key]; ok {
+			errs = append(errs, Problem{
+				Line:    keyNode.Line,
+				Message: fmt.Sprintf("%s is already set on line %d", key, line),
+			})
+			continue
+		}
+		lines[key] = keyNode.Line
+
+		switch {
+		case key == profilesKey && allowProfiles:
+			profileErrs, profileWarnings := validateProfiles(valueNode)
+			errs = append(errs, profileErrs...)
+			warnings = append(warnings, profileWarnings...)
+		case key == profilesKey:
+			errs = append(errs, Problem{
+				Line:    keyNode.Line,
+				Message: fmt.Sprintf("profile %s can't have its own profiles", profile),
+			})
+		case !IsSetting(key):
+			warnings = append(warnings, Problem{
+				Line:    keyNode.Line,
+				Message: fmt.Sprintf("%s is not a supported setting and is ignored", key),
+			})
+		default:
+			var value interface{}
+			err := valueNode.Decode(&value)
+			if err == nil {
+				_, err = NewConfig(map[string]interface{}{key: value})
+			}
+			if err != nil {
+				errs = append(errs, Problem{Line: valueNode.Line, Message: err.Error()})
+			}
+		}
+	}
+
+	return errs, warnings
+}
+
+func validateProfiles(node *yaml.Node) ([]Problem, []Problem) {
+	if node.Kind != yaml.MappingNode {
+		return []Problem{{Line: node.Line, Message: "profiles must be a map of profile names to settings"}}, nil
+	}
+
+	errs := make([]Problem, 0)
+	warnings := make([]Problem, 0)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		name, profileNode := node.Content[i].Value, node.Content[i+1]
+		// a profile without settings is empty
+		if profileNode.Tag == "!!null" {
+			continue
+		}
+		profileErrs, profileWarnings := validateSettings(profileNode, name, false)
+		errs = append(errs, profileErrs...)
+		warnings = append(warnings, profileWarnings...)
+	}
+
+	return errs, warnings
+}
+
+// yamlProblem gets the line number from a YAML syntax error, such as "yaml: line 2: did not find
+// expected key".
+func yamlProblem(err error) Problem {
+	matches := yamlLinePattern.FindStringSubmatch(err.Error())
+	if matches == nil {
+		return Problem{Message: err.Error()}
+	}
+	line, _ := strconv.Atoi(matches[1])
+
+	return Problem{Line: line, Message: matches[2]}
+}
@@ -0,0 +1,116 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"ldcli/internal/config"
+)
+
+func TestValidate(t *testing.T) {
+	tests := map[string]struct {
+		data             string
+		expectedErrs     []string
+		expectedWarnings []string
+	}{
+		"with valid settings": {
+			data: `access-token: test-token
+max-retries: 2
+profiles:
+  fed:
+    base-uri: https://app.launchdarkly.us
+  empty:
+`,
+		},
+		"with an empty file": {
+			data: "",
+		},
+		"with invalid values": {
+			data: `access-token: [test-token]
+output: xml
+profiles:
+  fed:
+    max-retries: -1
+`,
+			expectedErrs: []string{
+				"line 1: access-token must be a string",
+				"line 2: output is invalid",
+				"line 5: max-retries must be a number greater than or equal to 0",
+			},
+		},
+		"with lists and maps for string settings": {
+			data: `credential-helper: [op, read]
+environment: {key: production}
+project: [default]
+timeout: {seconds: 30}
+`,
+			expectedErrs: []string{
+				"line 1: credential-helper must be a string",
+				"line 2: environment must be a string",
+				"line 3: project must be a string",
+				"line 4: timeout must be a string",
+			},
+		},
+		"with unknown settings": {
+			data: `acess-token: test-token
+profiles:
+  fed:
+    projcet: default
+`,
+			expectedWarnings: []string{
+				"line 1: acess-token is not a supported setting and is ignored",
+				"line 4: projcet is not a supported setting and is ignored",
+			},
+		},
+		"with a setting set twice": {
+			data: `project: default
+project: other
+`,
+			expectedErrs: []string{
+				"line 2: project is already set on line 1",
+			},
+		},
+		"with invalid YAML": {
+			data: `project: default
+  environment: production
+`,
+			expectedErrs: []string{
+				"line 2: mapping values are not allowed in this context",
+			},
+		},
+		"with a profile in a profile": {
+			data: `profiles:
+  fed:
+    profiles:
+      other:
+        project: default
+`,
+			expectedErrs: []string{
+				"line 3: profile fed can't have its own profiles",
+			},
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			errs, warnings := config.Validate([]byte(tt.data))
+
+			assert.Equal(t, tt.expectedErrs, problemStrings(errs))
+			assert.Equal(t, tt.expectedWarnings, problemStrings(warnings))
+		})
+	}
+}
+
+func problemStrings(problems []config.Problem) []string {
+	if len(problems) == 0 {
+		return nil
+	}
+
+	s := make([]string, 0, len(problems))
+	for _, p := range problems {
+		s = append(s, p.String())
+	}
+
+	return s
+}
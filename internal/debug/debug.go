@@ -0,0 +1,277 @@
+package debug
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EnvVar is the environment variable that turns on debug logging without the verbose flag. It's
+// set to a level, such as LD_DEBUG=2, or to true for requests only.
+const EnvVar = "LD_DEBUG"
+
+// Level is how much the CLI logs about the requests it makes.
+type Level int
+
+const (
+	// LevelOff doesn't log anything.
+	LevelOff Level = iota
+	// LevelRequests logs the method, URL, status, latency, and request ID of each request.
+	LevelRequests
+	// LevelBodies also logs the headers and bodies of each request and response.
+	LevelBodies
+)
+
+const redacted = "[REDACTED]"
+
+// secretFields are the fields in JSON and form bodies with credentials, such as the access token
+// from logging in or the token to revoke when logging out.
+var secretFields = map[string]bool{
+	"access_token":  true,
+	"apiKey":        true,
+	"client_secret": true,
+	"device_code":   true,
+	"mobileKey":     true,
+	"refresh_token": true,
+	"token":         true,
+}
+
+var (
+	level  Level
+	mu     sync.Mutex
+	output io.Writer = os.Stderr
+)
+
+// SetLevel sets how much to log. The level comes from flags, so it's set when a command runs
+// instead of when the clients are created.
+func SetLevel(l Level) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	level = l
+}
+
+// SetOutput sets where to log. It's stderr by default.
+func SetOutput(w io.Writer) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	output = w
+}
+
+// LevelFromEnv gets the level from the LD_DEBUG environment variable's value.
+func LevelFromEnv(value string) Level {
+	if value == "" {
+		return LevelOff
+	}
+	if l, err := strconv.Atoi(value); err == nil {
+		return Level(l)
+	}
+	if on, err := strconv.ParseBool(value); err == nil && !on {
+		return LevelOff
+	}
+
+	return LevelRequests
+}
+
+// Enabled checks if debug logging is on.
+func Enabled() bool {
+	return currentLevel() > LevelOff
+}
+
+func currentLevel() Level {
+	mu.Lock()
+	defer mu.Unlock()
+
+	return level
+}
+
+// NewTransport wraps the transport to log each request it sends when debug logging is on.
+func NewTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return transport{base: base}
+}
+
+type transport struct {
+	base http.RoundTripper
+}
+
+func (t transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	l := currentLevel()
+	if l <= LevelOff {
+		return t.base.RoundTrip(req)
+	}
+
+	var entry strings.Builder
+	fmt.Fprintf(&entry, "> %s %s\n", req.Method, req.URL.Redacted())
+	if l >= LevelBodies {
+		writeHeaders(&entry, "> ", req.Header)
+		body, err := readRequestBody(req)
+		if err != nil {
+			return nil, err
+		}
+		writeBody(&entry, "> ", redactBody(body))
+	}
+	write(entry.String())
+
+	start := time.Now()
+	res, err := t.base.RoundTrip(req)
+	latency := time.Since(start).Round(time.Millisecond)
+	entry.Reset()
+	if err != nil {
+		fmt.Fprintf(&entry, "< %s %s failed after %s: %s\n", req.Method, req.URL.Redacted(), latency, err.Error())
+		write(entry.String())
+
+		return nil, err
+	}
+
+	fmt.Fprintf(&entry, "< %s %s %s in %s", req.Method, req.URL.Redacted(), res.Status, latency)
+	if requestID := res.Header.Get("X-Request-Id"); requestID != "" {
+		fmt.Fprintf(&entry, " (request ID %s)", requestID)
+	}
+	entry.WriteString("\n")
+	if l >= LevelBodies {
+		writeHeaders(&entry, "< ", res.Header)
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		res.Body = io.NopCloser(bytes.NewReader(body))
+		writeBody(&entry, "< ", redactBody(body))
+	}
+	write(entry.String())
+
+	return res, nil
+}
+
+// readRequestBody reads a copy of the body when the request has one. Otherwise it reads the body
+// and replaces it so it can still be sent.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+
+		return io.ReadAll(body)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+func writeHeaders(w io.Writer, prefix string, header http.Header) {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, value := range header[name] {
+			if http.CanonicalHeaderKey(name) == "Authorization" {
+				value = redacted
+			}
+			fmt.Fprintf(w, "%s%s: %s\n", prefix, name, value)
+		}
+	}
+}
+
+func writeBody(w io.Writer, prefix string, body []byte) {
+	if len(body) == 0 {
+		return
+	}
+
+	fmt.Fprintln(w, strings.TrimSpace(prefix))
+	for _, line := range strings.Split(strings.TrimRight(string(body), "\n"), "\n") {
+		fmt.Fprintf(w, "%s%s\n", prefix, line)
+	}
+}
+
+// redactBody replaces the values of secret fields in a JSON or form body. The content type isn't
+// checked since servers don't always set it, and other bodies don't have fields like "token=".
+func redactBody(body []byte) []byte {
+	var data interface{}
+	if json.Unmarshal(body, &data) != nil {
+		return redactForm(body)
+	}
+	if !redactJSON(data) {
+		return body
+	}
+	redactedBody, err := json.Marshal(data)
+	if err != nil {
+		return []byte(redacted)
+	}
+
+	return redactedBody
+}
+
+// redactForm replaces the values of secret fields in the form and keeps the other fields in the
+// same order.
+func redactForm(body []byte) []byte {
+	fields := strings.Split(string(body), "&")
+	for i, field := range fields {
+		key, _, _ := strings.Cut(field, "=")
+		if name, err := url.QueryUnescape(key); err == nil && secretFields[name] {
+			fields[i] = key + "=" + redacted
+		}
+	}
+
+	return []byte(strings.Join(fields, "&"))
+}
+
+// redactJSON replaces the values of secret fields anywhere in the data. It returns whether it
+// replaced any.
+func redactJSON(data interface{}) bool {
+	changed := false
+	switch v := data.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if secretFields[key] {
+				if _, ok := value.(string); ok {
+					v[key] = redacted
+					changed = true
+					continue
+				}
+			}
+			changed = redactJSON(value) || changed
+		}
+	case []interface{}:
+		for _, value := range v {
+			changed = redactJSON(value) || changed
+		}
+	}
+
+	return changed
+}
+
+// write logs the entry at once so entries from requests sent at the same time aren't mixed up.
+func write(entry string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	fmt.Fprint(output, entry)
+}
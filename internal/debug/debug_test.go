@@ -0,0 +1,148 @@
+package debug_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ldcli/internal/debug"
+)
+
+func TestTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("X-Request-Id", "test-request-id")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	send := func(t *testing.T) *http.Response {
+		t.Helper()
+		req, err := http.NewRequest("POST", server.URL+"/api/v2/flags/default", strings.NewReader(`{"key": "test-flag"}`))
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "api-secret-token")
+		client := http.Client{Transport: debug.NewTransport(nil)}
+
+		res, err := client.Do(req)
+		require.NoError(t, err)
+
+		return res
+	}
+
+	t.Run("doesn't log when it's off", func(t *testing.T) {
+		var out bytes.Buffer
+		debug.SetOutput(&out)
+		defer debug.SetOutput(os.Stderr)
+
+		res := send(t)
+		defer res.Body.Close()
+
+		assert.Empty(t, out.String())
+	})
+
+	t.Run("logs the request and response", func(t *testing.T) {
+		var out bytes.Buffer
+		debug.SetOutput(&out)
+		debug.SetLevel(debug.LevelRequests)
+		defer debug.SetOutput(os.Stderr)
+		defer debug.SetLevel(debug.LevelOff)
+
+		res := send(t)
+		defer res.Body.Close()
+
+		assert.Contains(t, out.String(), "> POST "+server.URL+"/api/v2/flags/default\n")
+		assert.Regexp(t, `< POST http://\S+/api/v2/flags/default 201 Created in \S+ \(request ID test-request-id\)`, out.String())
+		assert.NotContains(t, out.String(), "test-flag")
+		assert.NotContains(t, out.String(), "Authorization")
+	})
+
+	t.Run("logs the headers and bodies with the access token redacted", func(t *testing.T) {
+		var out bytes.Buffer
+		debug.SetOutput(&out)
+		debug.SetLevel(debug.LevelBodies)
+		defer debug.SetOutput(os.Stderr)
+		defer debug.SetLevel(debug.LevelOff)
+
+		res := send(t)
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+
+		assert.Contains(t, out.String(), "> Authorization: [REDACTED]\n")
+		assert.NotContains(t, out.String(), "api-secret-token")
+		assert.Contains(t, out.String(), ">\n> {\"key\": \"test-flag\"}\n")
+		assert.Contains(t, out.String(), "< X-Request-Id: test-request-id\n")
+		assert.Contains(t, out.String(), "<\n< {\"key\": \"test-flag\"}\n")
+		assert.JSONEq(t, `{"key": "test-flag"}`, string(body), "the response body can still be read")
+	})
+
+	t.Run("logs the bodies with secret fields redacted", func(t *testing.T) {
+		var out bytes.Buffer
+		debug.SetOutput(&out)
+		debug.SetLevel(debug.LevelBodies)
+		defer debug.SetOutput(os.Stderr)
+		defer debug.SetLevel(debug.LevelOff)
+		client := http.Client{Transport: debug.NewTransport(nil)}
+
+		res, err := client.Post(
+			server.URL+"/oauth/revoke",
+			"application/x-www-form-urlencoded",
+			strings.NewReader("client_id=ldcli&token=secret-form-token"),
+		)
+		require.NoError(t, err)
+		res.Body.Close()
+		res, err = client.Post(
+			server.URL+"/oauth/token",
+			"application/json",
+			strings.NewReader(`{"access_token": "secret-json-token", "items": [{"apiKey": "sdk-secret"}], "token_type": "bearer"}`),
+		)
+		require.NoError(t, err)
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		require.NoError(t, err)
+
+		assert.Contains(t, out.String(), ">\n> client_id=ldcli&token=[REDACTED]\n")
+		assert.Contains(t, out.String(), `< {"access_token":"[REDACTED]","items":[{"apiKey":"[REDACTED]"}],"token_type":"bearer"}`)
+		assert.NotContains(t, out.String(), "secret-form-token")
+		assert.NotContains(t, out.String(), "secret-json-token")
+		assert.NotContains(t, out.String(), "sdk-secret")
+		assert.Contains(t, string(body), "secret-json-token", "the response body isn't changed")
+	})
+}
+
+func TestLevelFromEnv(t *testing.T) {
+	tests := map[string]struct {
+		value    string
+		expected debug.Level
+	}{
+		"with no value": {
+			value:    "",
+			expected: debug.LevelOff,
+		},
+		"with a level": {
+			value:    "2",
+			expected: debug.LevelBodies,
+		},
+		"with true": {
+			value:    "true",
+			expected: debug.LevelRequests,
+		},
+		"with false": {
+			value:    "false",
+			expected: debug.LevelOff,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, debug.LevelFromEnv(tt.value))
+		})
+	}
+}
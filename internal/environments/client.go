@@ -32,15 +32,15 @@ func (c EnvironmentsClient) Get(
 	projectKey string,
 ) ([]byte, error) {
 	client := client.New(accessToken, baseURI, c.cliVersion)
-	environment, _, err := client.EnvironmentsApi.GetEnvironment(ctx, projectKey, key).Execute()
+	environment, res, err := client.EnvironmentsApi.GetEnvironment(ctx, projectKey, key).Execute()
 	if err != nil {
-		return nil, errors.NewLDAPIError(err)
+		return nil, errors.NewLDAPIError(err, res)
 
 	}
 
 	output, err := json.Marshal(environment)
 	if err != nil {
-		return nil, errors.NewLDAPIError(err)
+		return nil, errors.NewLDAPIError(err, res)
 
 	}
 
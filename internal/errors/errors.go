@@ -3,6 +3,7 @@ package errors
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
 
 	"github.com/pkg/errors"
 
@@ -78,26 +79,30 @@ func (e APIError) Model() interface{} {
 }
 
 // NewLDAPIError converts the error returned from API calls to LaunchDarkly to have a
-// consistent Error() JSON structure.
-func NewLDAPIError(err error) error {
+// consistent Error() JSON structure. With the response, it's a response error so it has the same
+// exit code and details as errors from other API requests.
+func NewLDAPIError(err error, res *http.Response) error {
 	var apiErr LDAPIError
 	ok := errors.As(err, &apiErr)
-	if ok {
-		// the 401 response does not have a body, so we need to create one for a
-		// consistent response
-		if err.Error() == "401 Unauthorized" {
-			errMsg, err := normalizeUnauthorizedJSON()
-			if err != nil {
-				return err
-			}
-
-			return NewError(string(errMsg))
-		}
+	if !ok {
+		return err
+	}
 
-		return NewErrorWrapped(string(apiErr.Body()), apiErr)
+	body := apiErr.Body()
+	// the 401 response does not have a body, so we need to create one for a
+	// consistent response
+	if err.Error() == "401 Unauthorized" {
+		errMsg, err := normalizeUnauthorizedJSON()
+		if err != nil {
+			return err
+		}
+		body = errMsg
+	}
+	if res == nil {
+		return NewErrorWrapped(string(body), apiErr)
 	}
 
-	return err
+	return NewResponseError(res.StatusCode, res.Header.Get("X-Request-Id"), body)
 }
 
 func normalizeUnauthorizedJSON() ([]byte, error) {
@@ -115,3 +120,80 @@ func normalizeUnauthorizedJSON() ([]byte, error) {
 
 	return errMsg, nil
 }
+
+// ResponseError is an error response from the LaunchDarkly API along with its status code and the
+// ID LaunchDarkly gave the request, which support uses to find it.
+type ResponseError struct {
+	Body       []byte
+	RequestID  string
+	StatusCode int
+}
+
+func (e ResponseError) Error() string {
+	return string(e.Body)
+}
+
+// NewResponseError creates an error from an API response. The error message is the response body
+// so it's shown the same way as other API errors.
+func NewResponseError(statusCode int, requestID string, body []byte) error {
+	return NewErrorWrapped(string(body), ResponseError{
+		Body:       body,
+		RequestID:  requestID,
+		StatusCode: statusCode,
+	})
+}
+
+// UsageError is an error from running a command the wrong way, such as with a flag that doesn't
+// exist or without a required flag.
+type UsageError struct {
+	err error
+}
+
+func (e UsageError) Error() string {
+	return e.err.Error()
+}
+
+func (e UsageError) Unwrap() error {
+	return e.err
+}
+
+func NewUsageError(err error) error {
+	return UsageError{
+		err: err,
+	}
+}
+
+// UnauthorizedError is an error from running a command without the credentials it needs, such as
+// without an access token.
+type UnauthorizedError struct {
+	err error
+}
+
+func (e UnauthorizedError) Error() string {
+	return e.err.Error()
+}
+
+func (e UnauthorizedError) Unwrap() error {
+	return e.err
+}
+
+func NewUnauthorizedError(err error) error {
+	return UnauthorizedError{
+		err: err,
+	}
+}
+
+// IsNotFound checks if the error is an API response for a resource that doesn't exist.
+func IsNotFound(err error) bool {
+	var responseErr ResponseError
+
+	return errors.As(err, &responseErr) && responseErr.StatusCode == http.StatusNotFound
+}
+
+// IsForbidden checks if the error is an API response for an action the access token or account
+// doesn't have access to.
+func IsForbidden(err error) bool {
+	var responseErr ResponseError
+
+	return errors.As(err, &responseErr) && responseErr.StatusCode == http.StatusForbidden
+}
@@ -3,6 +3,7 @@ package errors_test
 import (
 	"encoding/json"
 	"errors"
+	"net/http"
 	"testing"
 
 	ldapi "github.com/launchdarkly/api-client-go/v14"
@@ -20,7 +21,7 @@ func TestAPIError(t *testing.T) {
 			[]string{},
 		)
 
-		err := errs.NewLDAPIError(underlying)
+		err := errs.NewLDAPIError(underlying, nil)
 
 		require.Error(t, err)
 		assert.JSONEq(t, `{"code": "conflict", "message": "an error"}`, err.Error())
@@ -36,7 +37,7 @@ func TestAPIError(t *testing.T) {
 			[]string{},
 		)
 
-		err = errs.NewLDAPIError(underlying)
+		err = errs.NewLDAPIError(underlying, nil)
 
 		require.Error(t, err)
 		assert.JSONEq(t, `{"code": "unauthorized", "message": "You do not have access to perform this action"}`, err.Error())
@@ -49,9 +50,30 @@ func TestAPIError(t *testing.T) {
 			[]string{},
 		)
 
-		err := errs.NewLDAPIError(underlying)
+		err := errs.NewLDAPIError(underlying, nil)
 
 		require.Error(t, err)
 		assert.JSONEq(t, `{"code": "forbidden", "message": "an error"}`, err.Error())
 	})
+
+	t.Run("with a response is a response error", func(t *testing.T) {
+		underlying := errs.NewAPIError(
+			[]byte(`{"code": "not_found", "message": "Unknown resource"}`),
+			errors.New("404 Not Found"),
+			[]string{},
+		)
+		res := &http.Response{
+			Header:     http.Header{"X-Request-Id": {"request-id"}},
+			StatusCode: http.StatusNotFound,
+		}
+
+		err := errs.NewLDAPIError(underlying, res)
+
+		var responseErr errs.ResponseError
+		require.ErrorAs(t, err, &responseErr)
+		assert.Equal(t, http.StatusNotFound, responseErr.StatusCode)
+		assert.Equal(t, "request-id", responseErr.RequestID)
+		assert.Equal(t, errs.ExitCodeNotFound, errs.ExitCode(err))
+		assert.JSONEq(t, `{"code": "not_found", "message": "Unknown resource"}`, err.Error())
+	})
 }
@@ -0,0 +1,91 @@
+package errors
+
+import (
+	"errors"
+	"net"
+	"net/http"
+)
+
+// Exit codes let scripts handle a failure based on its cause. They're part of the CLI's interface,
+// so they shouldn't change.
+const (
+	ExitCodeError        = 1
+	ExitCodeUsage        = 2
+	ExitCodeUnauthorized = 3
+	ExitCodeNotFound     = 4
+	ExitCodeConflict     = 5
+	ExitCodeRateLimited  = 6
+	ExitCodeServerError  = 7
+	ExitCodeNetwork      = 8
+)
+
+// ExitCode gets the exit code for the cause of the error.
+func ExitCode(err error) int {
+	var usageErr UsageError
+	if errors.As(err, &usageErr) {
+		return ExitCodeUsage
+	}
+
+	var unauthorizedErr UnauthorizedError
+	if errors.As(err, &unauthorizedErr) {
+		return ExitCodeUnauthorized
+	}
+
+	var responseErr ResponseError
+	if errors.As(err, &responseErr) {
+		switch {
+		case responseErr.StatusCode == http.StatusUnauthorized, responseErr.StatusCode == http.StatusForbidden:
+			return ExitCodeUnauthorized
+		case responseErr.StatusCode == http.StatusNotFound:
+			return ExitCodeNotFound
+		case responseErr.StatusCode == http.StatusConflict:
+			return ExitCodeConflict
+		case responseErr.StatusCode == http.StatusTooManyRequests:
+			return ExitCodeRateLimited
+		case responseErr.StatusCode >= http.StatusInternalServerError:
+			return ExitCodeServerError
+		}
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return ExitCodeNetwork
+	}
+
+	return ExitCodeError
+}
+
+// Code gets a short name for the cause of the error for scripts that read errors as JSON.
+func Code(err error) string {
+	var responseErr ResponseError
+	if errors.As(err, &responseErr) {
+		switch responseErr.StatusCode {
+		case http.StatusBadRequest:
+			return "invalid_request"
+		case http.StatusUnauthorized:
+			return "unauthorized"
+		case http.StatusForbidden:
+			return "forbidden"
+		case http.StatusNotFound:
+			return "not_found"
+		case http.StatusConflict:
+			return "conflict"
+		case http.StatusTooManyRequests:
+			return "rate_limited"
+		}
+		if responseErr.StatusCode >= http.StatusInternalServerError {
+			return "server_error"
+		}
+	}
+
+	switch ExitCode(err) {
+	case ExitCodeUsage:
+		return "usage_error"
+	case ExitCodeUnauthorized:
+		return "unauthorized"
+	case ExitCodeNetwork:
+		return "network_error"
+	default:
+		return "error"
+	}
+}
@@ -0,0 +1,92 @@
+package errors_test
+
+import (
+	"errors"
+	"net"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	errs "ldcli/internal/errors"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := map[string]struct {
+		err              error
+		expectedCode     string
+		expectedExitCode int
+	}{
+		"with a usage error": {
+			err:              errs.NewUsageError(errors.New(`unknown flag: --nope`)),
+			expectedCode:     "usage_error",
+			expectedExitCode: errs.ExitCodeUsage,
+		},
+		"with an unauthorized error": {
+			err:              errs.NewUnauthorizedError(errors.New(`required flag(s) "access-token" not set`)),
+			expectedCode:     "unauthorized",
+			expectedExitCode: errs.ExitCodeUnauthorized,
+		},
+		"with a 400 response": {
+			err:              errs.NewResponseError(400, "", []byte(`{}`)),
+			expectedCode:     "invalid_request",
+			expectedExitCode: errs.ExitCodeError,
+		},
+		"with a 401 response": {
+			err:              errs.NewResponseError(401, "", []byte(`{}`)),
+			expectedCode:     "unauthorized",
+			expectedExitCode: errs.ExitCodeUnauthorized,
+		},
+		"with a 403 response": {
+			err:              errs.NewResponseError(403, "", []byte(`{}`)),
+			expectedCode:     "forbidden",
+			expectedExitCode: errs.ExitCodeUnauthorized,
+		},
+		"with a 404 response": {
+			err:              errs.NewResponseError(404, "", []byte(`{}`)),
+			expectedCode:     "not_found",
+			expectedExitCode: errs.ExitCodeNotFound,
+		},
+		"with a 409 response": {
+			err:              errs.NewResponseError(409, "", []byte(`{}`)),
+			expectedCode:     "conflict",
+			expectedExitCode: errs.ExitCodeConflict,
+		},
+		"with a 429 response": {
+			err:              errs.NewResponseError(429, "", []byte(`{}`)),
+			expectedCode:     "rate_limited",
+			expectedExitCode: errs.ExitCodeRateLimited,
+		},
+		"with a 503 response": {
+			err:              errs.NewResponseError(503, "", []byte(`{}`)),
+			expectedCode:     "server_error",
+			expectedExitCode: errs.ExitCodeServerError,
+		},
+		"with a network error": {
+			err: &url.Error{
+				Op:  "Get",
+				URL: "http://localhost",
+				Err: &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")},
+			},
+			expectedCode:     "network_error",
+			expectedExitCode: errs.ExitCodeNetwork,
+		},
+		"with a wrapped response error": {
+			err:              errs.NewErrorWrapped("Unknown project", errs.NewResponseError(404, "", []byte(`{}`))),
+			expectedCode:     "not_found",
+			expectedExitCode: errs.ExitCodeNotFound,
+		},
+		"with another error": {
+			err:              errs.NewError("an error"),
+			expectedCode:     "error",
+			expectedExitCode: errs.ExitCodeError,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedExitCode, errs.ExitCode(tt.err))
+			assert.Equal(t, tt.expectedCode, errs.Code(tt.err))
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package evaluation
+
+import (
+	"fmt"
+	"net/url"
+
+	"ldcli/internal/resources"
+)
+
+// APIDataSource gets flags and segments in an environment from the API. It keeps each one it gets
+// so flags and segments used more than once are only requested once.
+type APIDataSource struct {
+	client      resources.Client
+	accessToken string
+	baseURI     string
+	projKey     string
+	envKey      string
+	flags       map[string]Flag
+	segments    map[string]Segment
+}
+
+var _ DataSource = &APIDataSource{}
+
+func NewAPIDataSource(client resources.Client, accessToken, baseURI, projKey, envKey string) *APIDataSource {
+	return &APIDataSource{
+		client:      client,
+		accessToken: accessToken,
+		baseURI:     baseURI,
+		projKey:     projKey,
+		envKey:      envKey,
+		flags:       make(map[string]Flag),
+		segments:    make(map[string]Segment),
+	}
+}
+
+func (d *APIDataSource) Flag(key string) (Flag, error) {
+	if flag, ok := d.flags[key]; ok {
+		return flag, nil
+	}
+
+	res, err := d.client.MakeRequest(
+		d.accessToken,
+		"GET",
+		fmt.Sprintf("%s/api/v2/flags/%s/%s", d.baseURI, d.projKey, key),
+		"application/json",
+		url.Values{"env": {d.envKey}},
+		nil,
+	)
+	if err != nil {
+		return Flag{}, err
+	}
+	flag, err := NewFlag(res, d.envKey)
+	if err != nil {
+		return Flag{}, err
+	}
+	d.flags[key] = flag
+
+	return flag, nil
+}
+
+func (d *APIDataSource) Segment(key string) (Segment, error) {
+	if segment, ok := d.segments[key]; ok {
+		return segment, nil
+	}
+
+	res, err := d.client.MakeRequest(
+		d.accessToken,
+		"GET",
+		fmt.Sprintf("%s/api/v2/segments/%s/%s/%s", d.baseURI, d.projKey, d.envKey, key),
+		"application/json",
+		nil,
+		nil,
+	)
+	if err != nil {
+		return Segment{}, err
+	}
+	segment, err := NewSegment(res)
+	if err != nil {
+		return Segment{}, err
+	}
+	d.segments[key] = segment
+
+	return segment, nil
+}
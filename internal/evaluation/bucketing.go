@@ -0,0 +1,60 @@
+package evaluation
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"math"
+	"strconv"
+)
+
+// bucketScale is the largest value of the 15 hex digits of the hash used for bucketing.
+const bucketScale = float32(0xFFFFFFFFFFFFFFF)
+
+// bucket gets a number from 0 to 1 for the context that's always the same for the same key, salt,
+// and attribute value. Contexts are put into a rollout's variations or a segment rule's share by
+// comparing their bucket with the weights. It returns false if the context doesn't have the kind
+// or the attribute can't be used for bucketing.
+func bucket(context Context, contextKind string, attribute string, key string, salt string, seed *int) (float32, bool) {
+	attrs, ok := context.individual(contextKind)
+	if !ok {
+		return 0, false
+	}
+	if attribute == "" {
+		attribute = "key"
+	}
+	value, ok := bucketableValue(attributeValue(attrs, attribute, contextKind != ""))
+	if !ok {
+		return 0, true
+	}
+
+	prefix := key + "." + salt
+	if seed != nil {
+		prefix = strconv.Itoa(*seed)
+	}
+	// SHA-1 is part of the bucketing algorithm so the buckets match the SDKs'
+	hash := sha1.Sum([]byte(prefix + "." + value))
+	hexHash := hex.EncodeToString(hash[:])[:15]
+	intValue, err := strconv.ParseInt(hexHash, 16, 64)
+	if err != nil {
+		return 0, true
+	}
+
+	return float32(intValue) / bucketScale, true
+}
+
+// bucketableValue gets the string to hash for an attribute value. Only strings and whole numbers
+// can be used for bucketing.
+func bucketableValue(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case float64:
+		if v != math.Trunc(v) {
+			return "", false
+		}
+
+		return strconv.FormatInt(int64(v), 10), true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,82 @@
+package evaluation
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// the expected buckets are the same as in the SDKs' tests
+func TestBucket(t *testing.T) {
+	seed := 61
+	tests := map[string]struct {
+		context   string
+		attribute string
+		seed      *int
+		expected  float32
+	}{
+		"by key": {
+			context:  `{"kind": "user", "key": "userKeyA"}`,
+			expected: 0.42157587,
+		},
+		"by key for another context": {
+			context:  `{"kind": "user", "key": "userKeyB"}`,
+			expected: 0.6708485,
+		},
+		"by a whole number attribute": {
+			context:   `{"kind": "user", "key": "userKeyA", "intAttr": 33333}`,
+			attribute: "intAttr",
+			expected:  0.54771423,
+		},
+		"by a string attribute with a whole number": {
+			context:   `{"kind": "user", "key": "userKeyA", "stringAttr": "33333"}`,
+			attribute: "stringAttr",
+			expected:  0.54771423,
+		},
+		"with a seed": {
+			context:  `{"kind": "user", "key": "userKeyA"}`,
+			seed:     &seed,
+			expected: 0.09801207,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			context := testContext(t, tt.context)
+
+			b, ok := bucket(context, "user", tt.attribute, "hashKey", "saltyA", tt.seed)
+
+			assert.True(t, ok)
+			assert.InEpsilon(t, tt.expected, b, 0.0000001)
+		})
+	}
+
+	t.Run("with an attribute that can't be used for bucketing", func(t *testing.T) {
+		context := testContext(t, `{"kind": "user", "key": "userKeyA", "floatAttr": 999.999}`)
+
+		b, ok := bucket(context, "user", "floatAttr", "hashKey", "saltyA", nil)
+
+		assert.True(t, ok)
+		assert.Zero(t, b)
+	})
+
+	t.Run("without the context kind", func(t *testing.T) {
+		context := testContext(t, `{"kind": "org", "key": "org-key"}`)
+
+		b, ok := bucket(context, "user", "", "hashKey", "saltyA", nil)
+
+		assert.False(t, ok)
+		assert.Zero(t, b)
+	})
+}
+
+func testContext(t *testing.T, data string) Context {
+	var value interface{}
+	require.NoError(t, json.Unmarshal([]byte(data), &value))
+	context, err := NewContext(value)
+	require.NoError(t, err)
+
+	return context
+}
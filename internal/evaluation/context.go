@@ -0,0 +1,182 @@
+package evaluation
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"ldcli/internal/errors"
+)
+
+const (
+	defaultKind = "user"
+	multiKind   = "multi"
+)
+
+var validKind = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+
+// Context is the context a flag is evaluated for. It's either a single context or a
+// multi-context with a context for each kind.
+type Context struct {
+	multi    bool
+	contexts map[string]map[string]interface{}
+}
+
+// NewContext gets a context from its JSON representation, such as
+// {"kind": "user", "key": "user-key-123abc"}. A context without a kind is a user in the older user
+// format, which keeps custom attributes in "custom".
+func NewContext(value interface{}) (Context, error) {
+	// values from YAML have different types for numbers, so they're converted to JSON types
+	data, err := json.Marshal(value)
+	if err != nil {
+		return Context{}, err
+	}
+	var attrs map[string]interface{}
+	err = json.Unmarshal(data, &attrs)
+	if err != nil || attrs == nil {
+		return Context{}, errors.NewError("context must be a JSON object")
+	}
+
+	kind, hasKind := attrs["kind"]
+	if !hasKind {
+		return newUserContext(attrs)
+	}
+	kindStr, _ := kind.(string)
+	if kindStr != multiKind {
+		individual, err := newIndividualContext(kindStr, attrs)
+		if err != nil {
+			return Context{}, err
+		}
+
+		return Context{contexts: map[string]map[string]interface{}{kindStr: individual}}, nil
+	}
+
+	c := Context{multi: true, contexts: make(map[string]map[string]interface{})}
+	for k, v := range attrs {
+		if k == "kind" {
+			continue
+		}
+		individualAttrs, ok := v.(map[string]interface{})
+		if !ok {
+			return Context{}, errors.NewError(fmt.Sprintf("context for kind %s must be a JSON object", k))
+		}
+		individual, err := newIndividualContext(k, individualAttrs)
+		if err != nil {
+			return Context{}, err
+		}
+		c.contexts[k] = individual
+	}
+	if len(c.contexts) == 0 {
+		return Context{}, errors.NewError("multi-context must have at least one context")
+	}
+
+	return c, nil
+}
+
+// newUserContext gets a user context from the older user format. Its custom attributes are moved
+// to the top level like attributes of other contexts.
+func newUserContext(attrs map[string]interface{}) (Context, error) {
+	if _, ok := attrs["key"].(string); !ok {
+		return Context{}, errors.NewError("context must have a key")
+	}
+
+	user := make(map[string]interface{}, len(attrs))
+	for k, v := range attrs {
+		if k == "custom" {
+			continue
+		}
+		user[k] = v
+	}
+	if custom, ok := attrs["custom"].(map[string]interface{}); ok {
+		for k, v := range custom {
+			if _, exists := user[k]; !exists {
+				user[k] = v
+			}
+		}
+	}
+	user["kind"] = defaultKind
+
+	return Context{contexts: map[string]map[string]interface{}{defaultKind: user}}, nil
+}
+
+func newIndividualContext(kind string, attrs map[string]interface{}) (map[string]interface{}, error) {
+	if kind == "kind" || kind == multiKind || !validKind.MatchString(kind) {
+		return nil, errors.NewError(fmt.Sprintf("%q isn't a valid context kind", kind))
+	}
+	key, _ := attrs["key"].(string)
+	if key == "" {
+		return nil, errors.NewError(fmt.Sprintf("context for kind %s must have a key", kind))
+	}
+
+	individual := make(map[string]interface{}, len(attrs)+1)
+	for k, v := range attrs {
+		individual[k] = v
+	}
+	individual["kind"] = kind
+
+	return individual, nil
+}
+
+// Kinds gets the kinds of the contexts, sorted by name.
+func (c Context) Kinds() []string {
+	kinds := make([]string, 0, len(c.contexts))
+	for kind := range c.contexts {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	return kinds
+}
+
+// individual gets the context for the kind. An empty kind is a user.
+func (c Context) individual(kind string) (map[string]interface{}, bool) {
+	if kind == "" {
+		kind = defaultKind
+	}
+	attrs, ok := c.contexts[kind]
+
+	return attrs, ok
+}
+
+// key gets the key of the context for the kind.
+func (c Context) key(kind string) (string, bool) {
+	attrs, ok := c.individual(kind)
+	if !ok {
+		return "", false
+	}
+	key, _ := attrs["key"].(string)
+
+	return key, true
+}
+
+// attributeValue gets the value of an attribute of the context. With a context kind, the attribute
+// is a reference, which is either an attribute name or a path to a value in an object attribute,
+// such as /address/city. Without one, it's the name of an attribute of the user, as in the older
+// user format.
+func attributeValue(attrs map[string]interface{}, attribute string, isReference bool) interface{} {
+	if !isReference || !strings.HasPrefix(attribute, "/") {
+		if attribute == "_meta" {
+			return nil
+		}
+
+		return attrs[attribute]
+	}
+
+	var value interface{} = attrs
+	unescape := strings.NewReplacer("~1", "/", "~0", "~")
+	for i, component := range strings.Split(attribute[1:], "/") {
+		component = unescape.Replace(component)
+		if i == 0 && component == "_meta" {
+			return nil
+		}
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = m[component]
+	}
+
+	return value
+}
@@ -0,0 +1,73 @@
+package evaluation_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ldcli/internal/evaluation"
+)
+
+func TestNewContext(t *testing.T) {
+	tests := map[string]struct {
+		data          string
+		expectedKinds []string
+		expectedErr   string
+	}{
+		"with a single context": {
+			data:          `{"kind": "org", "key": "org-key"}`,
+			expectedKinds: []string{"org"},
+		},
+		"with a user in the older user format": {
+			data:          `{"key": "user-key", "custom": {"plan": "pro"}}`,
+			expectedKinds: []string{"user"},
+		},
+		"with a multi-context": {
+			data:          `{"kind": "multi", "user": {"key": "user-key"}, "org": {"key": "org-key"}}`,
+			expectedKinds: []string{"org", "user"},
+		},
+		"with a value that isn't an object": {
+			data:        `"user-key"`,
+			expectedErr: "context must be a JSON object",
+		},
+		"without a key": {
+			data:        `{"kind": "user", "name": "Ada"}`,
+			expectedErr: "context for kind user must have a key",
+		},
+		"with a user without a key": {
+			data:        `{"name": "Ada"}`,
+			expectedErr: "context must have a key",
+		},
+		"with an invalid kind": {
+			data:        `{"kind": "org!", "key": "org-key"}`,
+			expectedErr: `"org!" isn't a valid context kind`,
+		},
+		"with a multi-context without contexts": {
+			data:        `{"kind": "multi"}`,
+			expectedErr: "multi-context must have at least one context",
+		},
+		"with a multi-context with a context that isn't an object": {
+			data:        `{"kind": "multi", "user": "user-key"}`,
+			expectedErr: "context for kind user must be a JSON object",
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			var value interface{}
+			require.NoError(t, json.Unmarshal([]byte(tt.data), &value))
+
+			context, err := evaluation.NewContext(value)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedKinds, context.Kinds())
+		})
+	}
+}
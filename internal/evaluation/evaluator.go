@@ -0,0 +1,424 @@
+package evaluation
+
+import (
+	goerrors "errors"
+	"fmt"
+
+	"ldcli/internal/errors"
+)
+
+// The kinds of evaluation reasons, which are the same as the SDKs'.
+const (
+	ReasonError              = "ERROR"
+	ReasonFallthrough        = "FALLTHROUGH"
+	ReasonOff                = "OFF"
+	ReasonPrerequisiteFailed = "PREREQUISITE_FAILED"
+	ReasonRuleMatch          = "RULE_MATCH"
+	ReasonTargetMatch        = "TARGET_MATCH"
+)
+
+// ErrorMalformedFlag is the error kind for a flag that can't be evaluated, such as one that serves
+// a variation that doesn't exist or has prerequisites that depend on each other.
+const ErrorMalformedFlag = "MALFORMED_FLAG"
+
+// Reason is why the context got the variation.
+type Reason struct {
+	Kind            string `json:"kind"`
+	RuleIndex       *int   `json:"ruleIndex,omitempty"`
+	RuleID          string `json:"ruleId,omitempty"`
+	PrerequisiteKey string `json:"prerequisiteKey,omitempty"`
+	InExperiment    bool   `json:"inExperiment,omitempty"`
+	ErrorKind       string `json:"errorKind,omitempty"`
+}
+
+// Result is the variation a context gets for a flag and the reason for it. Warnings are about
+// parts of the targeting that couldn't be evaluated the way an SDK would.
+type Result struct {
+	FlagKey        string      `json:"flagKey"`
+	Value          interface{} `json:"value"`
+	VariationIndex *int        `json:"variationIndex"`
+	Reason         Reason      `json:"reason"`
+	Warnings       []string    `json:"warnings,omitempty"`
+}
+
+// DataSource gets the flags and segments a flag's targeting refers to. It returns a not found
+// response error for ones that don't exist.
+type DataSource interface {
+	Flag(key string) (Flag, error)
+	Segment(key string) (Segment, error)
+}
+
+// Evaluator evaluates flags the same way the SDKs do, using the targeting from a data source
+// instead of an SDK's stored flags.
+type Evaluator struct {
+	source DataSource
+}
+
+func NewEvaluator(source DataSource) Evaluator {
+	return Evaluator{
+		source: source,
+	}
+}
+
+// Evaluate gets the variation the context gets for the flag. It returns an error if it can't get a
+// prerequisite flag or segment. A flag that can't be evaluated gets an error reason instead.
+func (e Evaluator) Evaluate(flag Flag, context Context) (Result, error) {
+	s := &scope{
+		source:   e.source,
+		context:  context,
+		flags:    make(map[string]bool),
+		segments: make(map[string]bool),
+	}
+	result, err := s.evaluate(flag)
+	var malformedErr malformedFlagError
+	if goerrors.As(err, &malformedErr) {
+		s.warn(malformedErr.Error())
+		result = Result{Reason: Reason{Kind: ReasonError, ErrorKind: ErrorMalformedFlag}}
+		err = nil
+	}
+	if err != nil {
+		return Result{}, err
+	}
+	result.FlagKey = flag.Key
+	result.Warnings = s.warnings
+
+	return result, nil
+}
+
+// malformedFlagError is an error in a flag's targeting that keeps it from being evaluated.
+type malformedFlagError struct {
+	message string
+}
+
+func (e malformedFlagError) Error() string {
+	return e.message
+}
+
+// scope is the state for evaluating one flag, including the prerequisite flags and segments
+// being evaluated to find cycles.
+type scope struct {
+	source   DataSource
+	context  Context
+	flags    map[string]bool
+	segments map[string]bool
+	warnings []string
+}
+
+func (s *scope) evaluate(flag Flag) (Result, error) {
+	if !flag.On {
+		return s.offResult(flag, Reason{Kind: ReasonOff})
+	}
+
+	s.flags[flag.Key] = true
+	defer delete(s.flags, flag.Key)
+	for _, p := range flag.Prerequisites {
+		met, err := s.prerequisiteMet(p)
+		if err != nil {
+			return Result{}, err
+		}
+		if !met {
+			return s.offResult(flag, Reason{Kind: ReasonPrerequisiteFailed, PrerequisiteKey: p.Key})
+		}
+	}
+
+	if variation, ok := s.targetMatch(flag); ok {
+		return variationResult(flag, variation, Reason{Kind: ReasonTargetMatch})
+	}
+
+	for i, rule := range flag.Rules {
+		match, err := s.clausesMatch(rule.Clauses)
+		if err != nil {
+			return Result{}, err
+		}
+		if match {
+			ruleIndex := i
+
+			return s.variationOrRolloutResult(flag, rule.VariationOrRollout, Reason{
+				Kind:      ReasonRuleMatch,
+				RuleIndex: &ruleIndex,
+				RuleID:    rule.ID,
+			})
+		}
+	}
+
+	return s.variationOrRolloutResult(flag, flag.Fallthrough, Reason{Kind: ReasonFallthrough})
+}
+
+// prerequisiteMet checks if the prerequisite flag is on and serves the required variation to the
+// context.
+func (s *scope) prerequisiteMet(p Prerequisite) (bool, error) {
+	if s.flags[p.Key] {
+		return false, malformedFlagError{message: fmt.Sprintf("prerequisite flag %s depends on itself", p.Key)}
+	}
+
+	prerequisite, err := s.source.Flag(p.Key)
+	if errors.IsNotFound(err) {
+		s.warn(fmt.Sprintf("prerequisite flag %s doesn't exist", p.Key))
+
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	result, err := s.evaluate(prerequisite)
+	if err != nil {
+		return false, err
+	}
+
+	return prerequisite.On && result.VariationIndex != nil && *result.VariationIndex == p.Variation, nil
+}
+
+// targetMatch finds the variation the context is individually targeted for. Context targets for
+// users without values keep the order of the targets, which are for users.
+func (s *scope) targetMatch(flag Flag) (int, bool) {
+	userKey, hasUser := s.context.key(defaultKind)
+	if len(flag.ContextTargets) == 0 {
+		for _, t := range flag.Targets {
+			if hasUser && contains(t.Values, userKey) {
+				return t.Variation, true
+			}
+		}
+
+		return 0, false
+	}
+
+	for _, ct := range flag.ContextTargets {
+		if (ct.ContextKind == "" || ct.ContextKind == defaultKind) && len(ct.Values) == 0 {
+			for _, t := range flag.Targets {
+				if t.Variation == ct.Variation && hasUser && contains(t.Values, userKey) {
+					return t.Variation, true
+				}
+			}
+			continue
+		}
+		if key, ok := s.context.key(ct.ContextKind); ok && contains(ct.Values, key) {
+			return ct.Variation, true
+		}
+	}
+
+	return 0, false
+}
+
+func (s *scope) clausesMatch(clauses []Clause) (bool, error) {
+	for _, c := range clauses {
+		match, err := s.clauseMatches(c)
+		if err != nil || !match {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// clauseMatches checks if the context matches the clause. A clause for a kind the context doesn't
+// have or an attribute it doesn't have doesn't match, even if it's negated.
+func (s *scope) clauseMatches(c Clause) (bool, error) {
+	if c.Op == "segmentMatch" {
+		for _, v := range c.Values {
+			key, ok := v.(string)
+			if !ok {
+				continue
+			}
+			match, err := s.segmentMatch(key)
+			if err != nil {
+				return false, err
+			}
+			if match {
+				return !c.Negate, nil
+			}
+		}
+
+		return c.Negate, nil
+	}
+
+	op, ok := operators[c.Op]
+	if !ok {
+		s.warn(fmt.Sprintf("operator %s isn't supported, so clauses with it don't match", c.Op))
+
+		return false, nil
+	}
+
+	if c.Attribute == "kind" {
+		for _, kind := range s.context.Kinds() {
+			if matchAny(op, kind, c.Values) {
+				return !c.Negate, nil
+			}
+		}
+
+		return c.Negate, nil
+	}
+
+	attrs, ok := s.context.individual(c.ContextKind)
+	if !ok {
+		return false, nil
+	}
+	value := attributeValue(attrs, c.Attribute, c.ContextKind != "")
+	if value == nil {
+		return false, nil
+	}
+	if values, ok := value.([]interface{}); ok {
+		for _, v := range values {
+			if matchAny(op, v, c.Values) {
+				return !c.Negate, nil
+			}
+		}
+
+		return c.Negate, nil
+	}
+
+	return matchAny(op, value, c.Values) != c.Negate, nil
+}
+
+func matchAny(op operatorFn, contextValue interface{}, clauseValues []interface{}) bool {
+	for _, v := range clauseValues {
+		if op(contextValue, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// segmentMatch checks if the context is in the segment. Contexts that are included are in it even
+// if they're also excluded.
+func (s *scope) segmentMatch(key string) (bool, error) {
+	if s.segments[key] {
+		return false, malformedFlagError{message: fmt.Sprintf("segment %s depends on itself", key)}
+	}
+
+	segment, err := s.source.Segment(key)
+	if errors.IsNotFound(err) {
+		s.warn(fmt.Sprintf("segment %s doesn't exist", key))
+
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if segment.Unbounded {
+		s.warn(fmt.Sprintf("segment %s is a big segment, which can't be evaluated locally, so contexts aren't in it", key))
+
+		return false, nil
+	}
+
+	userKey, hasUser := s.context.key(defaultKind)
+	if hasUser && contains(segment.Included, userKey) {
+		return true, nil
+	}
+	for _, t := range segment.IncludedContexts {
+		if contextKey, ok := s.context.key(t.ContextKind); ok && contains(t.Values, contextKey) {
+			return true, nil
+		}
+	}
+	if hasUser && contains(segment.Excluded, userKey) {
+		return false, nil
+	}
+	for _, t := range segment.ExcludedContexts {
+		if contextKey, ok := s.context.key(t.ContextKind); ok && contains(t.Values, contextKey) {
+			return false, nil
+		}
+	}
+
+	s.segments[key] = true
+	defer delete(s.segments, key)
+	for _, rule := range segment.Rules {
+		match, err := s.clausesMatch(rule.Clauses)
+		if err != nil {
+			return false, err
+		}
+		if !match {
+			continue
+		}
+		if rule.Weight == nil {
+			return true, nil
+		}
+
+		if segment.Salt == "" {
+			s.warn(fmt.Sprintf(
+				"segment %s has a rule for a percentage of contexts, which may not match the SDKs because the API doesn't return the segment's salt",
+				key,
+			))
+		}
+		b, _ := bucket(s.context, rule.RolloutContextKind, rule.BucketBy, segment.Key, segment.Salt, nil)
+
+		return b < float32(*rule.Weight)/100000, nil
+	}
+
+	return false, nil
+}
+
+func (s *scope) offResult(flag Flag, reason Reason) (Result, error) {
+	if flag.OffVariation == nil {
+		return Result{Reason: reason}, nil
+	}
+
+	return variationResult(flag, *flag.OffVariation, reason)
+}
+
+// variationOrRolloutResult gets the variation to serve. For a rollout, the context's bucket picks
+// the variation. The context is in an experiment if the rollout is for one and the variation is
+// tracked.
+func (s *scope) variationOrRolloutResult(flag Flag, vr VariationOrRollout, reason Reason) (Result, error) {
+	if vr.Variation != nil {
+		return variationResult(flag, *vr.Variation, reason)
+	}
+	if vr.Rollout == nil || len(vr.Rollout.Variations) == 0 {
+		return Result{}, malformedFlagError{message: "a rule or the fallthrough doesn't have a variation or rollout"}
+	}
+
+	rollout := vr.Rollout
+	isExperiment := rollout.ExperimentAllocation != nil
+	bucketBy := rollout.BucketBy
+	if isExperiment {
+		// experiments always bucket by key
+		bucketBy = ""
+	}
+	b, bucketed := bucket(s.context, rollout.ContextKind, bucketBy, flag.Key, flag.Salt, rollout.Seed)
+
+	// the last variation is used if rounding errors keep the weights from adding up to 1
+	chosen := rollout.Variations[len(rollout.Variations)-1]
+	var sum float32
+	for _, wv := range rollout.Variations {
+		sum += float32(wv.Weight) / 100000
+		if b < sum {
+			chosen = wv
+			break
+		}
+	}
+	reason.InExperiment = isExperiment && bucketed && !chosen.Untracked
+
+	return variationResult(flag, chosen.Variation, reason)
+}
+
+func variationResult(flag Flag, index int, reason Reason) (Result, error) {
+	if index < 0 || index >= len(flag.Variations) {
+		return Result{}, malformedFlagError{message: fmt.Sprintf("flag %s doesn't have variation %d", flag.Key, index)}
+	}
+
+	return Result{
+		Value:          flag.Variations[index],
+		VariationIndex: &index,
+		Reason:         reason,
+	}, nil
+}
+
+// warn adds a warning to the result if it doesn't have it already.
+func (s *scope) warn(warning string) {
+	for _, w := range s.warnings {
+		if w == warning {
+			return
+		}
+	}
+	s.warnings = append(s.warnings, warning)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
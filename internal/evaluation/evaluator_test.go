@@ -0,0 +1,450 @@
+package evaluation_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ldcli/internal/errors"
+	"ldcli/internal/evaluation"
+)
+
+// fakeDataSource has the flags and segments for a test. It returns a not found error for others.
+type fakeDataSource struct {
+	flags    map[string]evaluation.Flag
+	segments map[string]evaluation.Segment
+}
+
+func (d fakeDataSource) Flag(key string) (evaluation.Flag, error) {
+	if flag, ok := d.flags[key]; ok {
+		return flag, nil
+	}
+
+	return evaluation.Flag{}, errors.NewResponseError(404, "", []byte(`{"code": "not_found"}`))
+}
+
+func (d fakeDataSource) Segment(key string) (evaluation.Segment, error) {
+	if segment, ok := d.segments[key]; ok {
+		return segment, nil
+	}
+
+	return evaluation.Segment{}, errors.NewResponseError(404, "", []byte(`{"code": "not_found"}`))
+}
+
+func TestEvaluate(t *testing.T) {
+	flag := func(f evaluation.Flag) evaluation.Flag {
+		f.Key = "test-flag"
+		f.Variations = []interface{}{"a", "b", "c"}
+		f.OffVariation = intPtr(2)
+		if f.Fallthrough.Variation == nil && f.Fallthrough.Rollout == nil {
+			f.Fallthrough = evaluation.VariationOrRollout{Variation: intPtr(0)}
+		}
+
+		return f
+	}
+	rule := func(clauses ...evaluation.Clause) []evaluation.Rule {
+		return []evaluation.Rule{{
+			ID:                 "rule-id",
+			Clauses:            clauses,
+			VariationOrRollout: evaluation.VariationOrRollout{Variation: intPtr(1)},
+		}}
+	}
+	ruleMatch := evaluation.Reason{Kind: evaluation.ReasonRuleMatch, RuleIndex: intPtr(0), RuleID: "rule-id"}
+	fallthroughReason := evaluation.Reason{Kind: evaluation.ReasonFallthrough}
+
+	tests := map[string]struct {
+		flag              evaluation.Flag
+		source            fakeDataSource
+		context           string
+		expectedVariation *int
+		expectedReason    evaluation.Reason
+		expectedWarnings  []string
+	}{
+		"when the flag is off": {
+			flag:              flag(evaluation.Flag{On: false}),
+			context:           `{"kind": "user", "key": "user-key"}`,
+			expectedVariation: intPtr(2),
+			expectedReason:    evaluation.Reason{Kind: evaluation.ReasonOff},
+		},
+		"when the flag is off without an off variation": {
+			flag: func() evaluation.Flag {
+				f := flag(evaluation.Flag{On: false})
+				f.OffVariation = nil
+
+				return f
+			}(),
+			context:        `{"kind": "user", "key": "user-key"}`,
+			expectedReason: evaluation.Reason{Kind: evaluation.ReasonOff},
+		},
+		"with the fallthrough": {
+			flag:              flag(evaluation.Flag{On: true}),
+			context:           `{"kind": "user", "key": "user-key"}`,
+			expectedVariation: intPtr(0),
+			expectedReason:    fallthroughReason,
+		},
+		"with a user target": {
+			flag: flag(evaluation.Flag{
+				On:      true,
+				Targets: []evaluation.Target{{Values: []string{"user-key"}, Variation: 1}},
+			}),
+			context:           `{"key": "user-key"}`,
+			expectedVariation: intPtr(1),
+			expectedReason:    evaluation.Reason{Kind: evaluation.ReasonTargetMatch},
+		},
+		"with a context target": {
+			flag: flag(evaluation.Flag{
+				On:             true,
+				ContextTargets: []evaluation.Target{{Values: []string{"org-key"}, Variation: 1, ContextKind: "org"}},
+			}),
+			context:           `{"kind": "multi", "user": {"key": "user-key"}, "org": {"key": "org-key"}}`,
+			expectedVariation: intPtr(1),
+			expectedReason:    evaluation.Reason{Kind: evaluation.ReasonTargetMatch},
+		},
+		"with a context target for another kind": {
+			flag: flag(evaluation.Flag{
+				On:             true,
+				ContextTargets: []evaluation.Target{{Values: []string{"org-key"}, Variation: 1, ContextKind: "org"}},
+			}),
+			context:           `{"kind": "user", "key": "org-key"}`,
+			expectedVariation: intPtr(0),
+			expectedReason:    fallthroughReason,
+		},
+		"with a prerequisite that's met": {
+			flag: flag(evaluation.Flag{
+				On:            true,
+				Prerequisites: []evaluation.Prerequisite{{Key: "other-flag", Variation: 0}},
+			}),
+			source: fakeDataSource{flags: map[string]evaluation.Flag{
+				"other-flag": {
+					Key:         "other-flag",
+					On:          true,
+					Variations:  []interface{}{true, false},
+					Fallthrough: evaluation.VariationOrRollout{Variation: intPtr(0)},
+				},
+			}},
+			context:           `{"kind": "user", "key": "user-key"}`,
+			expectedVariation: intPtr(0),
+			expectedReason:    fallthroughReason,
+		},
+		"with a prerequisite that's off": {
+			flag: flag(evaluation.Flag{
+				On:            true,
+				Prerequisites: []evaluation.Prerequisite{{Key: "other-flag", Variation: 0}},
+			}),
+			source: fakeDataSource{flags: map[string]evaluation.Flag{
+				"other-flag": {
+					Key:          "other-flag",
+					On:           false,
+					OffVariation: intPtr(0),
+					Variations:   []interface{}{true, false},
+				},
+			}},
+			context:           `{"kind": "user", "key": "user-key"}`,
+			expectedVariation: intPtr(2),
+			expectedReason:    evaluation.Reason{Kind: evaluation.ReasonPrerequisiteFailed, PrerequisiteKey: "other-flag"},
+		},
+		"with a prerequisite that doesn't exist": {
+			flag: flag(evaluation.Flag{
+				On:            true,
+				Prerequisites: []evaluation.Prerequisite{{Key: "other-flag", Variation: 0}},
+			}),
+			context:           `{"kind": "user", "key": "user-key"}`,
+			expectedVariation: intPtr(2),
+			expectedReason:    evaluation.Reason{Kind: evaluation.ReasonPrerequisiteFailed, PrerequisiteKey: "other-flag"},
+			expectedWarnings:  []string{"prerequisite flag other-flag doesn't exist"},
+		},
+		"with prerequisites that depend on each other": {
+			flag: flag(evaluation.Flag{
+				On:            true,
+				Prerequisites: []evaluation.Prerequisite{{Key: "other-flag", Variation: 0}},
+			}),
+			source: fakeDataSource{flags: map[string]evaluation.Flag{
+				"other-flag": {
+					Key:           "other-flag",
+					On:            true,
+					Variations:    []interface{}{true, false},
+					Fallthrough:   evaluation.VariationOrRollout{Variation: intPtr(0)},
+					Prerequisites: []evaluation.Prerequisite{{Key: "test-flag", Variation: 0}},
+				},
+			}},
+			context:          `{"kind": "user", "key": "user-key"}`,
+			expectedReason:   evaluation.Reason{Kind: evaluation.ReasonError, ErrorKind: evaluation.ErrorMalformedFlag},
+			expectedWarnings: []string{"prerequisite flag test-flag depends on itself"},
+		},
+		"with a rule that matches": {
+			flag: flag(evaluation.Flag{
+				On:    true,
+				Rules: rule(evaluation.Clause{Attribute: "email", Op: "endsWith", Values: []interface{}{"@example.com"}}),
+			}),
+			context:           `{"kind": "user", "key": "user-key", "email": "test@example.com"}`,
+			expectedVariation: intPtr(1),
+			expectedReason:    ruleMatch,
+		},
+		"with a rule for a custom attribute in the user format": {
+			flag: flag(evaluation.Flag{
+				On:    true,
+				Rules: rule(evaluation.Clause{Attribute: "plan", Op: "in", Values: []interface{}{"pro"}}),
+			}),
+			context:           `{"key": "user-key", "custom": {"plan": "pro"}}`,
+			expectedVariation: intPtr(1),
+			expectedReason:    ruleMatch,
+		},
+		"with a negated rule": {
+			flag: flag(evaluation.Flag{
+				On:    true,
+				Rules: rule(evaluation.Clause{Attribute: "plan", Op: "in", Values: []interface{}{"pro"}, Negate: true}),
+			}),
+			context:           `{"kind": "user", "key": "user-key", "plan": "free"}`,
+			expectedVariation: intPtr(1),
+			expectedReason:    ruleMatch,
+		},
+		"with a negated rule for an attribute the context doesn't have": {
+			flag: flag(evaluation.Flag{
+				On:    true,
+				Rules: rule(evaluation.Clause{Attribute: "plan", Op: "in", Values: []interface{}{"pro"}, Negate: true}),
+			}),
+			context:           `{"kind": "user", "key": "user-key"}`,
+			expectedVariation: intPtr(0),
+			expectedReason:    fallthroughReason,
+		},
+		"with a rule for an array attribute": {
+			flag: flag(evaluation.Flag{
+				On:    true,
+				Rules: rule(evaluation.Clause{Attribute: "groups", Op: "in", Values: []interface{}{"beta"}}),
+			}),
+			context:           `{"kind": "user", "key": "user-key", "groups": ["alpha", "beta"]}`,
+			expectedVariation: intPtr(1),
+			expectedReason:    ruleMatch,
+		},
+		"with a rule for an attribute reference": {
+			flag: flag(evaluation.Flag{
+				On: true,
+				Rules: rule(evaluation.Clause{
+					Attribute:   "/address/city",
+					Op:          "in",
+					Values:      []interface{}{"Oakland"},
+					ContextKind: "user",
+				}),
+			}),
+			context:           `{"kind": "user", "key": "user-key", "address": {"city": "Oakland"}}`,
+			expectedVariation: intPtr(1),
+			expectedReason:    ruleMatch,
+		},
+		"with a rule for another context kind": {
+			flag: flag(evaluation.Flag{
+				On: true,
+				Rules: rule(evaluation.Clause{
+					Attribute:   "key",
+					Op:          "in",
+					Values:      []interface{}{"org-key"},
+					ContextKind: "org",
+				}),
+			}),
+			context:           `{"kind": "user", "key": "org-key"}`,
+			expectedVariation: intPtr(0),
+			expectedReason:    fallthroughReason,
+		},
+		"with a rule for the kind": {
+			flag: flag(evaluation.Flag{
+				On:    true,
+				Rules: rule(evaluation.Clause{Attribute: "kind", Op: "in", Values: []interface{}{"org"}}),
+			}),
+			context:           `{"kind": "multi", "user": {"key": "user-key"}, "org": {"key": "org-key"}}`,
+			expectedVariation: intPtr(1),
+			expectedReason:    ruleMatch,
+		},
+		"with a rule with an unsupported operator": {
+			flag: flag(evaluation.Flag{
+				On:    true,
+				Rules: rule(evaluation.Clause{Attribute: "key", Op: "unknownOp", Values: []interface{}{"user-key"}}),
+			}),
+			context:           `{"kind": "user", "key": "user-key"}`,
+			expectedVariation: intPtr(0),
+			expectedReason:    fallthroughReason,
+			expectedWarnings:  []string{"operator unknownOp isn't supported, so clauses with it don't match"},
+		},
+		"with a segment the context is included in": {
+			flag: flag(evaluation.Flag{
+				On:    true,
+				Rules: rule(evaluation.Clause{Op: "segmentMatch", Values: []interface{}{"test-segment"}}),
+			}),
+			source: fakeDataSource{segments: map[string]evaluation.Segment{
+				"test-segment": {Key: "test-segment", Included: []string{"user-key"}, Excluded: []string{"user-key"}},
+			}},
+			context:           `{"kind": "user", "key": "user-key"}`,
+			expectedVariation: intPtr(1),
+			expectedReason:    ruleMatch,
+		},
+		"with a segment the context is excluded from": {
+			flag: flag(evaluation.Flag{
+				On:    true,
+				Rules: rule(evaluation.Clause{Op: "segmentMatch", Values: []interface{}{"test-segment"}}),
+			}),
+			source: fakeDataSource{segments: map[string]evaluation.Segment{
+				"test-segment": {
+					Key:              "test-segment",
+					ExcludedContexts: []evaluation.SegmentTarget{{Values: []string{"org-key"}, ContextKind: "org"}},
+					Rules: []evaluation.SegmentRule{{
+						Clauses: []evaluation.Clause{{Attribute: "kind", Op: "in", Values: []interface{}{"org"}}},
+					}},
+				},
+			}},
+			context:           `{"kind": "org", "key": "org-key"}`,
+			expectedVariation: intPtr(0),
+			expectedReason:    fallthroughReason,
+		},
+		"with a segment rule the context matches": {
+			flag: flag(evaluation.Flag{
+				On:    true,
+				Rules: rule(evaluation.Clause{Op: "segmentMatch", Values: []interface{}{"test-segment"}}),
+			}),
+			source: fakeDataSource{segments: map[string]evaluation.Segment{
+				"test-segment": {
+					Key: "test-segment",
+					Rules: []evaluation.SegmentRule{{
+						Clauses: []evaluation.Clause{{Attribute: "name", Op: "startsWith", Values: []interface{}{"Ada"}}},
+					}},
+				},
+			}},
+			context:           `{"kind": "user", "key": "user-key", "name": "Ada Lovelace"}`,
+			expectedVariation: intPtr(1),
+			expectedReason:    ruleMatch,
+		},
+		"with a big segment": {
+			flag: flag(evaluation.Flag{
+				On:    true,
+				Rules: rule(evaluation.Clause{Op: "segmentMatch", Values: []interface{}{"test-segment"}}),
+			}),
+			source: fakeDataSource{segments: map[string]evaluation.Segment{
+				"test-segment": {Key: "test-segment", Unbounded: true},
+			}},
+			context:           `{"kind": "user", "key": "user-key"}`,
+			expectedVariation: intPtr(0),
+			expectedReason:    fallthroughReason,
+			expectedWarnings: []string{
+				"segment test-segment is a big segment, which can't be evaluated locally, so contexts aren't in it",
+			},
+		},
+		"with a segment that doesn't exist": {
+			flag: flag(evaluation.Flag{
+				On:    true,
+				Rules: rule(evaluation.Clause{Op: "segmentMatch", Values: []interface{}{"test-segment"}}),
+			}),
+			context:           `{"kind": "user", "key": "user-key"}`,
+			expectedVariation: intPtr(0),
+			expectedReason:    fallthroughReason,
+			expectedWarnings:  []string{"segment test-segment doesn't exist"},
+		},
+		"with a variation that doesn't exist": {
+			flag: flag(evaluation.Flag{
+				On:          true,
+				Fallthrough: evaluation.VariationOrRollout{Variation: intPtr(5)},
+			}),
+			context:          `{"kind": "user", "key": "user-key"}`,
+			expectedReason:   evaluation.Reason{Kind: evaluation.ReasonError, ErrorKind: evaluation.ErrorMalformedFlag},
+			expectedWarnings: []string{"flag test-flag doesn't have variation 5"},
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			result, err := evaluation.NewEvaluator(tt.source).Evaluate(tt.flag, newContext(t, tt.context))
+
+			require.NoError(t, err)
+			assert.Equal(t, "test-flag", result.FlagKey)
+			assert.Equal(t, tt.expectedVariation, result.VariationIndex)
+			assert.Equal(t, tt.expectedReason, result.Reason)
+			assert.Equal(t, tt.expectedWarnings, result.Warnings)
+		})
+	}
+
+	t.Run("with a rollout", func(t *testing.T) {
+		// the buckets for these keys are about 0.42 and 0.67
+		f := evaluation.Flag{
+			Key:        "hashKey",
+			Salt:       "saltyA",
+			On:         true,
+			Variations: []interface{}{"a", "b"},
+			Fallthrough: evaluation.VariationOrRollout{Rollout: &evaluation.Rollout{
+				Variations: []evaluation.WeightedVariation{
+					{Variation: 0, Weight: 50000},
+					{Variation: 1, Weight: 50000},
+				},
+			}},
+		}
+		evaluator := evaluation.NewEvaluator(fakeDataSource{})
+
+		resultA, err := evaluator.Evaluate(f, newContext(t, `{"kind": "user", "key": "userKeyA"}`))
+		require.NoError(t, err)
+		resultB, err := evaluator.Evaluate(f, newContext(t, `{"kind": "user", "key": "userKeyB"}`))
+		require.NoError(t, err)
+
+		assert.Equal(t, "a", resultA.Value)
+		assert.Equal(t, "b", resultB.Value)
+		assert.False(t, resultA.Reason.InExperiment)
+	})
+
+	t.Run("with an experiment", func(t *testing.T) {
+		f := evaluation.Flag{
+			Key:        "hashKey",
+			Salt:       "saltyA",
+			On:         true,
+			Variations: []interface{}{"a", "b"},
+			Fallthrough: evaluation.VariationOrRollout{Rollout: &evaluation.Rollout{
+				ExperimentAllocation: map[string]interface{}{"defaultVariation": 0},
+				Variations: []evaluation.WeightedVariation{
+					{Variation: 0, Weight: 50000},
+					{Variation: 1, Weight: 50000, Untracked: true},
+				},
+			}},
+		}
+		evaluator := evaluation.NewEvaluator(fakeDataSource{})
+
+		resultA, err := evaluator.Evaluate(f, newContext(t, `{"kind": "user", "key": "userKeyA"}`))
+		require.NoError(t, err)
+		resultB, err := evaluator.Evaluate(f, newContext(t, `{"kind": "user", "key": "userKeyB"}`))
+		require.NoError(t, err)
+
+		assert.True(t, resultA.Reason.InExperiment)
+		assert.False(t, resultB.Reason.InExperiment)
+	})
+
+	t.Run("with an error getting a prerequisite", func(t *testing.T) {
+		f := flag(evaluation.Flag{
+			On:            true,
+			Prerequisites: []evaluation.Prerequisite{{Key: "other-flag", Variation: 0}},
+		})
+		source := errorDataSource{err: errors.NewError("an error")}
+
+		_, err := evaluation.NewEvaluator(source).Evaluate(f, newContext(t, `{"kind": "user", "key": "user-key"}`))
+
+		assert.EqualError(t, err, "an error")
+	})
+}
+
+type errorDataSource struct {
+	err error
+}
+
+func (d errorDataSource) Flag(key string) (evaluation.Flag, error) {
+	return evaluation.Flag{}, d.err
+}
+
+func (d errorDataSource) Segment(key string) (evaluation.Segment, error) {
+	return evaluation.Segment{}, d.err
+}
+
+func newContext(t *testing.T, data string) evaluation.Context {
+	var value interface{}
+	require.NoError(t, json.Unmarshal([]byte(data), &value))
+	context, err := evaluation.NewContext(value)
+	require.NoError(t, err)
+
+	return context
+}
+
+func intPtr(i int) *int {
+	return &i
+}
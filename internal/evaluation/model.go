@@ -0,0 +1,141 @@
+package evaluation
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"ldcli/internal/errors"
+)
+
+// Flag is a flag's variations and its targeting in one environment, in the format the API
+// returns them.
+type Flag struct {
+	Key            string             `json:"-"`
+	Variations     []interface{}      `json:"-"`
+	On             bool               `json:"on"`
+	OffVariation   *int               `json:"offVariation"`
+	Fallthrough    VariationOrRollout `json:"fallthrough"`
+	Targets        []Target           `json:"targets"`
+	ContextTargets []Target           `json:"contextTargets"`
+	Rules          []Rule             `json:"rules"`
+	Prerequisites  []Prerequisite     `json:"prerequisites"`
+	Salt           string             `json:"salt"`
+}
+
+// VariationOrRollout is either a variation to serve or a percentage rollout of variations.
+type VariationOrRollout struct {
+	Variation *int     `json:"variation"`
+	Rollout   *Rollout `json:"rollout"`
+}
+
+type Rollout struct {
+	Variations           []WeightedVariation `json:"variations"`
+	ExperimentAllocation interface{}         `json:"experimentAllocation"`
+	Seed                 *int                `json:"seed"`
+	BucketBy             string              `json:"bucketBy"`
+	ContextKind          string              `json:"contextKind"`
+}
+
+// WeightedVariation is a variation in a rollout. The weight is in thousandths of a percent, so the
+// weights in a rollout add up to 100000.
+type WeightedVariation struct {
+	Variation int  `json:"variation"`
+	Weight    int  `json:"weight"`
+	Untracked bool `json:"_untracked"`
+}
+
+type Target struct {
+	Values      []string `json:"values"`
+	Variation   int      `json:"variation"`
+	ContextKind string   `json:"contextKind"`
+}
+
+type Rule struct {
+	ID          string   `json:"_id"`
+	Description string   `json:"description"`
+	Clauses     []Clause `json:"clauses"`
+	VariationOrRollout
+}
+
+type Clause struct {
+	Attribute   string        `json:"attribute"`
+	Op          string        `json:"op"`
+	Values      []interface{} `json:"values"`
+	ContextKind string        `json:"contextKind"`
+	Negate      bool          `json:"negate"`
+}
+
+type Prerequisite struct {
+	Key       string `json:"key"`
+	Variation int    `json:"variation"`
+}
+
+// Segment is a segment's targeting in one environment. The API doesn't return the salt for
+// segments, so it's only set if the response has one.
+type Segment struct {
+	Key              string          `json:"key"`
+	Included         []string        `json:"included"`
+	Excluded         []string        `json:"excluded"`
+	IncludedContexts []SegmentTarget `json:"includedContexts"`
+	ExcludedContexts []SegmentTarget `json:"excludedContexts"`
+	Rules            []SegmentRule   `json:"rules"`
+	Unbounded        bool            `json:"unbounded"`
+	Salt             string          `json:"salt"`
+}
+
+type SegmentTarget struct {
+	Values      []string `json:"values"`
+	ContextKind string   `json:"contextKind"`
+}
+
+// SegmentRule is a rule for the contexts in a segment. With a weight, only that share of the
+// contexts that match the clauses are in the segment.
+type SegmentRule struct {
+	Clauses            []Clause `json:"clauses"`
+	Weight             *int     `json:"weight"`
+	BucketBy           string   `json:"bucketBy"`
+	RolloutContextKind string   `json:"rolloutContextKind"`
+}
+
+// NewFlag gets the flag's targeting in the environment from an API response for the flag.
+func NewFlag(data []byte, envKey string) (Flag, error) {
+	var rep struct {
+		Key        string `json:"key"`
+		Variations []struct {
+			Value interface{} `json:"value"`
+		} `json:"variations"`
+		Environments map[string]json.RawMessage `json:"environments"`
+	}
+	err := json.Unmarshal(data, &rep)
+	if err != nil {
+		return Flag{}, err
+	}
+	env, ok := rep.Environments[envKey]
+	if !ok {
+		return Flag{}, errors.NewError(fmt.Sprintf("flag %s doesn't have environment %s", rep.Key, envKey))
+	}
+
+	var flag Flag
+	err = json.Unmarshal(env, &flag)
+	if err != nil {
+		return Flag{}, err
+	}
+	flag.Key = rep.Key
+	flag.Variations = make([]interface{}, 0, len(rep.Variations))
+	for _, v := range rep.Variations {
+		flag.Variations = append(flag.Variations, v.Value)
+	}
+
+	return flag, nil
+}
+
+// NewSegment gets a segment from an API response for the segment.
+func NewSegment(data []byte) (Segment, error) {
+	var segment Segment
+	err := json.Unmarshal(data, &segment)
+	if err != nil {
+		return Segment{}, err
+	}
+
+	return segment, nil
+}
@@ -0,0 +1,217 @@
+package evaluation
+
+import (
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// operatorFn checks if a context's attribute value matches one of a clause's values.
+type operatorFn func(contextValue interface{}, clauseValue interface{}) bool
+
+var operators = map[string]operatorFn{
+	"in":                 operatorIn,
+	"endsWith":           stringOperator(strings.HasSuffix),
+	"startsWith":         stringOperator(strings.HasPrefix),
+	"matches":            stringOperator(matchesRegex),
+	"contains":           stringOperator(strings.Contains),
+	"lessThan":           numberOperator(func(a, b float64) bool { return a < b }),
+	"lessThanOrEqual":    numberOperator(func(a, b float64) bool { return a <= b }),
+	"greaterThan":        numberOperator(func(a, b float64) bool { return a > b }),
+	"greaterThanOrEqual": numberOperator(func(a, b float64) bool { return a >= b }),
+	"before":             dateOperator(func(a, b time.Time) bool { return a.Before(b) }),
+	"after":              dateOperator(func(a, b time.Time) bool { return a.After(b) }),
+	"semVerEqual":        semVerOperator(func(c int) bool { return c == 0 }),
+	"semVerLessThan":     semVerOperator(func(c int) bool { return c < 0 }),
+	"semVerGreaterThan":  semVerOperator(func(c int) bool { return c > 0 }),
+}
+
+func operatorIn(contextValue interface{}, clauseValue interface{}) bool {
+	return reflect.DeepEqual(contextValue, clauseValue)
+}
+
+func stringOperator(fn func(string, string) bool) operatorFn {
+	return func(contextValue interface{}, clauseValue interface{}) bool {
+		a, ok := contextValue.(string)
+		if !ok {
+			return false
+		}
+		b, ok := clauseValue.(string)
+		if !ok {
+			return false
+		}
+
+		return fn(a, b)
+	}
+}
+
+func matchesRegex(value string, pattern string) bool {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return false
+	}
+
+	return re.MatchString(value)
+}
+
+func numberOperator(fn func(float64, float64) bool) operatorFn {
+	return func(contextValue interface{}, clauseValue interface{}) bool {
+		a, ok := contextValue.(float64)
+		if !ok {
+			return false
+		}
+		b, ok := clauseValue.(float64)
+		if !ok {
+			return false
+		}
+
+		return fn(a, b)
+	}
+}
+
+func dateOperator(fn func(time.Time, time.Time) bool) operatorFn {
+	return func(contextValue interface{}, clauseValue interface{}) bool {
+		a, ok := parseDate(contextValue)
+		if !ok {
+			return false
+		}
+		b, ok := parseDate(clauseValue)
+		if !ok {
+			return false
+		}
+
+		return fn(a, b)
+	}
+}
+
+// parseDate gets a time from either a number of milliseconds since the Unix epoch or an RFC 3339
+// timestamp.
+func parseDate(value interface{}) (time.Time, bool) {
+	switch v := value.(type) {
+	case float64:
+		return time.UnixMilli(int64(v)), true
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return time.Time{}, false
+		}
+
+		return t, true
+	default:
+		return time.Time{}, false
+	}
+}
+
+func semVerOperator(fn func(int) bool) operatorFn {
+	return func(contextValue interface{}, clauseValue interface{}) bool {
+		a, ok := contextValue.(string)
+		if !ok {
+			return false
+		}
+		b, ok := clauseValue.(string)
+		if !ok {
+			return false
+		}
+		versionA, ok := parseSemVer(a)
+		if !ok {
+			return false
+		}
+		versionB, ok := parseSemVer(b)
+		if !ok {
+			return false
+		}
+
+		return fn(versionA.compare(versionB))
+	}
+}
+
+// semVerPattern matches a semantic version. The minor and patch versions are optional, as they are
+// in the SDKs, so 2 is the same as 2.0.0.
+var semVerPattern = regexp.MustCompile(
+	`^(0|[1-9]\d*)(?:\.(0|[1-9]\d*))?(?:\.(0|[1-9]\d*))?` +
+		`(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+		`(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`,
+)
+
+type semVer struct {
+	major, minor, patch int
+	prerelease          []string
+}
+
+func parseSemVer(value string) (semVer, bool) {
+	match := semVerPattern.FindStringSubmatch(value)
+	if match == nil {
+		return semVer{}, false
+	}
+
+	var v semVer
+	v.major, _ = strconv.Atoi(match[1])
+	if match[2] != "" {
+		v.minor, _ = strconv.Atoi(match[2])
+	}
+	if match[3] != "" {
+		v.patch, _ = strconv.Atoi(match[3])
+	}
+	if match[4] != "" {
+		v.prerelease = strings.Split(match[4], ".")
+	}
+
+	return v, true
+}
+
+// compare compares the versions by the semantic versioning rules. The build metadata is ignored.
+func (v semVer) compare(other semVer) int {
+	for _, pair := range [][2]int{{v.major, other.major}, {v.minor, other.minor}, {v.patch, other.patch}} {
+		if c := compareInts(pair[0], pair[1]); c != 0 {
+			return c
+		}
+	}
+
+	// a version without a prerelease is greater than the same version with one
+	switch {
+	case len(v.prerelease) == 0 && len(other.prerelease) == 0:
+		return 0
+	case len(v.prerelease) == 0:
+		return 1
+	case len(other.prerelease) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(v.prerelease) && i < len(other.prerelease); i++ {
+		if c := comparePrereleaseIdentifiers(v.prerelease[i], other.prerelease[i]); c != 0 {
+			return c
+		}
+	}
+
+	return compareInts(len(v.prerelease), len(other.prerelease))
+}
+
+// comparePrereleaseIdentifiers compares numeric identifiers as numbers and others as strings.
+// Numeric identifiers are lower than others.
+func comparePrereleaseIdentifiers(a string, b string) int {
+	numA, errA := strconv.Atoi(a)
+	numB, errB := strconv.Atoi(b)
+	switch {
+	case errA == nil && errB == nil:
+		return compareInts(numA, numB)
+	case errA == nil:
+		return -1
+	case errB == nil:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+func compareInts(a int, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,56 @@
+package evaluation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperators(t *testing.T) {
+	tests := map[string]struct {
+		op           string
+		contextValue interface{}
+		clauseValue  interface{}
+		expected     bool
+	}{
+		"in with the same string":             {op: "in", contextValue: "a", clauseValue: "a", expected: true},
+		"in with a different string":          {op: "in", contextValue: "a", clauseValue: "b", expected: false},
+		"in with the same number":             {op: "in", contextValue: float64(99), clauseValue: float64(99), expected: true},
+		"in with a string and number":         {op: "in", contextValue: "99", clauseValue: float64(99), expected: false},
+		"in with the same bool":               {op: "in", contextValue: true, clauseValue: true, expected: true},
+		"startsWith":                          {op: "startsWith", contextValue: "xyz", clauseValue: "x", expected: true},
+		"startsWith without the prefix":       {op: "startsWith", contextValue: "xyz", clauseValue: "y", expected: false},
+		"endsWith":                            {op: "endsWith", contextValue: "xyz", clauseValue: "z", expected: true},
+		"contains":                            {op: "contains", contextValue: "xyz", clauseValue: "y", expected: true},
+		"contains with a number":              {op: "contains", contextValue: float64(99), clauseValue: "9", expected: false},
+		"matches":                             {op: "matches", contextValue: "hello world", clauseValue: `hello.*rld`, expected: true},
+		"matches a part":                      {op: "matches", contextValue: "hello world", clauseValue: `l+`, expected: true},
+		"matches with an invalid pattern":     {op: "matches", contextValue: "hello world", clauseValue: `***not a regex`, expected: false},
+		"lessThan":                            {op: "lessThan", contextValue: float64(1), clauseValue: float64(1.99999), expected: true},
+		"lessThan with the same number":       {op: "lessThan", contextValue: float64(1), clauseValue: float64(1), expected: false},
+		"lessThanOrEqual":                     {op: "lessThanOrEqual", contextValue: float64(1), clauseValue: float64(1), expected: true},
+		"greaterThan":                         {op: "greaterThan", contextValue: float64(2), clauseValue: float64(1.99999), expected: true},
+		"greaterThanOrEqual":                  {op: "greaterThanOrEqual", contextValue: float64(1), clauseValue: float64(1), expected: true},
+		"greaterThan with a string":           {op: "greaterThan", contextValue: "2", clauseValue: float64(1), expected: false},
+		"before with timestamps":              {op: "before", contextValue: float64(0), clauseValue: float64(1), expected: true},
+		"before with RFC 3339 dates":          {op: "before", contextValue: "2017-12-06T00:00:00.000-07:00", clauseValue: "2017-12-06T00:01:01.000-07:00", expected: true},
+		"before with a date and timestamp":    {op: "before", contextValue: "1970-01-01T00:00:00Z", clauseValue: float64(1000), expected: true},
+		"after with RFC 3339 dates":           {op: "after", contextValue: "2017-12-06T00:01:01.000-07:00", clauseValue: "2017-12-06T00:00:00.000-07:00", expected: true},
+		"after with an invalid date":          {op: "after", contextValue: "not a date", clauseValue: float64(0), expected: false},
+		"semVerEqual":                         {op: "semVerEqual", contextValue: "2.0.0", clauseValue: "2.0.0", expected: true},
+		"semVerEqual without a patch version": {op: "semVerEqual", contextValue: "2.0", clauseValue: "2.0.0", expected: true},
+		"semVerEqual without a minor version": {op: "semVerEqual", contextValue: "2", clauseValue: "2.0.0", expected: true},
+		"semVerEqual with build metadata":     {op: "semVerEqual", contextValue: "2.0.0+build", clauseValue: "2.0.0", expected: true},
+		"semVerLessThan":                      {op: "semVerLessThan", contextValue: "2.0.0", clauseValue: "2.0.1", expected: true},
+		"semVerLessThan with a prerelease":    {op: "semVerLessThan", contextValue: "2.0.1-rc1", clauseValue: "2.0.1", expected: true},
+		"semVerLessThan with prereleases":     {op: "semVerLessThan", contextValue: "2.0.1-rc.2", clauseValue: "2.0.1-rc.10", expected: true},
+		"semVerGreaterThan":                   {op: "semVerGreaterThan", contextValue: "2.0.1", clauseValue: "2.0.0", expected: true},
+		"semVerGreaterThan with an invalid":   {op: "semVerGreaterThan", contextValue: "2.0.x", clauseValue: "2.0.0", expected: false},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, operators[tt.op](tt.contextValue, tt.clauseValue))
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package flags
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"ldcli/internal/resources"
+)
+
+// listPageSize is how many flags to request at a time when listing them.
+const listPageSize = 100
+
+// GetFlag gets a flag with its targeting in every environment.
+func GetFlag(client resources.Client, accessToken, baseURI, projKey, key string) (map[string]interface{}, error) {
+	res, err := client.MakeRequest(
+		accessToken,
+		"GET",
+		fmt.Sprintf("%s/api/v2/flags/%s/%s", baseURI, projKey, key),
+		"application/json",
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, nil
+	}
+
+	var flag map[string]interface{}
+	err = json.Unmarshal(res, &flag)
+	if err != nil {
+		return nil, err
+	}
+
+	return flag, nil
+}
+
+// ListFlags gets every flag in the project with its targeting in every environment. It requests
+// each page of flags until it has all of them.
+func ListFlags(client resources.Client, accessToken, baseURI, projKey string) ([]map[string]interface{}, error) {
+	items := make([]map[string]interface{}, 0)
+	for {
+		res, err := client.MakeRequest(
+			accessToken,
+			"GET",
+			fmt.Sprintf("%s/api/v2/flags/%s", baseURI, projKey),
+			"application/json",
+			url.Values{
+				"limit":   {strconv.Itoa(listPageSize)},
+				"offset":  {strconv.Itoa(len(items))},
+				"summary": {"0"},
+			},
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+		// clients that print the request instead of sending it don't have a response
+		if len(res) == 0 {
+			return items, nil
+		}
+
+		var page struct {
+			Items      []map[string]interface{} `json:"items"`
+			TotalCount int                      `json:"totalCount"`
+		}
+		err = json.Unmarshal(res, &page)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page.Items...)
+
+		if len(page.Items) < listPageSize || len(items) >= page.TotalCount {
+			return items, nil
+		}
+	}
+}
+
+// ListFlagStatuses gets the status of each flag in the environment by the flag's key.
+func ListFlagStatuses(client resources.Client, accessToken, baseURI, projKey, envKey string) (map[string]FlagStatus, error) {
+	res, err := client.MakeRequest(
+		accessToken,
+		"GET",
+		fmt.Sprintf("%s/api/v2/flag-statuses/%s/%s", baseURI, projKey, envKey),
+		"application/json",
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var rep struct {
+		Items []struct {
+			Name          string     `json:"name"`
+			LastRequested *time.Time `json:"lastRequested"`
+			Links         map[string]struct {
+				Href string `json:"href"`
+			} `json:"_links"`
+		} `json:"items"`
+	}
+	err = json.Unmarshal(res, &rep)
+	if err != nil {
+		return nil, err
+	}
+
+	// the statuses only have the flag's key in the link to the flag
+	statuses := make(map[string]FlagStatus, len(rep.Items))
+	for _, item := range rep.Items {
+		href := item.Links["parent"].Href
+		key := href[strings.LastIndex(href, "/")+1:]
+		if key == "" {
+			continue
+		}
+		statuses[key] = FlagStatus{
+			Name:          item.Name,
+			LastRequested: item.LastRequested,
+		}
+	}
+
+	return statuses, nil
+}
+
+// GetCodeReferenceCounts gets the number of code references to each flag in the project across
+// the default branch of every repository. Flags without code references aren't included.
+func GetCodeReferenceCounts(client resources.Client, accessToken, baseURI, projKey string) (map[string]int, error) {
+	res, err := client.MakeRequest(
+		accessToken,
+		"GET",
+		fmt.Sprintf("%s/api/v2/code-refs/statistics/%s", baseURI, projKey),
+		"application/json",
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var rep struct {
+		Flags map[string][]struct {
+			HunkCount int `json:"hunkCount"`
+		} `json:"flags"`
+	}
+	err = json.Unmarshal(res, &rep)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int, len(rep.Flags))
+	for key, repos := range rep.Flags {
+		for _, repo := range repos {
+			counts[key] += repo.HunkCount
+		}
+	}
+
+	return counts, nil
+}
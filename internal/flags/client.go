@@ -52,9 +52,9 @@ func (c FlagsClient) Create(
 ) ([]byte, error) {
 	client := client.New(accessToken, baseURI, c.cliVersion)
 	post := ldapi.NewFeatureFlagBody(name, key)
-	flag, _, err := client.FeatureFlagsApi.PostFeatureFlag(ctx, projectKey).FeatureFlagBody(*post).Execute()
+	flag, res, err := client.FeatureFlagsApi.PostFeatureFlag(ctx, projectKey).FeatureFlagBody(*post).Execute()
 	if err != nil {
-		return nil, errors.NewLDAPIError(err)
+		return nil, errors.NewLDAPIError(err, res)
 	}
 
 	responseJSON, err := json.Marshal(flag)
@@ -74,9 +74,9 @@ func (c FlagsClient) Get(
 	environmentKey string,
 ) ([]byte, error) {
 	client := client.New(accessToken, baseURI, c.cliVersion)
-	flag, _, err := client.FeatureFlagsApi.GetFeatureFlag(ctx, projectKey, key).Env(environmentKey).Execute()
+	flag, res, err := client.FeatureFlagsApi.GetFeatureFlag(ctx, projectKey, key).Env(environmentKey).Execute()
 	if err != nil {
-		return nil, errors.NewLDAPIError(err)
+		return nil, errors.NewLDAPIError(err, res)
 	}
 
 	responseJSON, err := json.Marshal(flag)
@@ -100,12 +100,12 @@ func (c FlagsClient) Update(
 	for _, i := range input {
 		patch = append(patch, *ldapi.NewPatchOperation(i.Op, i.Path, i.Value))
 	}
-	flag, _, err := client.FeatureFlagsApi.
+	flag, res, err := client.FeatureFlagsApi.
 		PatchFeatureFlag(ctx, projKey, key).
 		PatchWithComment(*ldapi.NewPatchWithComment(patch)).
 		Execute()
 	if err != nil {
-		return nil, errors.NewLDAPIError(err)
+		return nil, errors.NewLDAPIError(err, res)
 	}
 
 	responseJSON, err := json.Marshal(flag)
@@ -0,0 +1,101 @@
+package flags
+
+import (
+	"fmt"
+
+	"ldcli/internal/errors"
+)
+
+// diffSettings are the targeting settings compared between environments, in the order they're
+// shown.
+var diffSettings = []string{
+	"on",
+	"offVariation",
+	"fallthrough",
+	"prerequisites",
+	"targets",
+	"contextTargets",
+	"rules",
+}
+
+// listSettings are the settings with a list of values. Their differences are shown as the values
+// added and removed instead of the whole list.
+var listSettings = map[string]bool{
+	"prerequisites":  true,
+	"targets":        true,
+	"contextTargets": true,
+	"rules":          true,
+}
+
+// SettingDiff is a targeting setting that's different in two environments. For settings with a
+// list of values, Added and Removed are the values only in the second and first environment.
+type SettingDiff struct {
+	Setting string        `json:"setting"`
+	From    interface{}   `json:"from"`
+	To      interface{}   `json:"to"`
+	Added   []interface{} `json:"added,omitempty"`
+	Removed []interface{} `json:"removed,omitempty"`
+}
+
+// EnvironmentConfig gets the targeting for the flag in the environment.
+func EnvironmentConfig(flag map[string]interface{}, envKey string) (map[string]interface{}, error) {
+	environments, _ := flag["environments"].(map[string]interface{})
+	env, ok := environments[envKey].(map[string]interface{})
+	if !ok {
+		return nil, errors.NewError(fmt.Sprintf("environment %s doesn't exist", envKey))
+	}
+
+	return env, nil
+}
+
+// DiffEnvironments gets the targeting settings that are different in the two environments. IDs the
+// API generates are ignored since they're different in each environment.
+func DiffEnvironments(from map[string]interface{}, to map[string]interface{}) []SettingDiff {
+	diffs := make([]SettingDiff, 0)
+	for _, s := range diffSettings {
+		fromValue := withoutEmptyList(normalizeSetting(s, from[s]))
+		toValue := withoutEmptyList(normalizeSetting(s, to[s]))
+		if equalJSON(fromValue, toValue) {
+			continue
+		}
+
+		diff := SettingDiff{Setting: s, From: fromValue, To: toValue}
+		if listSettings[s] {
+			fromItems, _ := fromValue.([]interface{})
+			toItems, _ := toValue.([]interface{})
+			diff.Added = missingItems(toItems, fromItems)
+			diff.Removed = missingItems(fromItems, toItems)
+		}
+		diffs = append(diffs, diff)
+	}
+
+	return diffs
+}
+
+// withoutEmptyList changes an empty list to nil since the API leaves out some empty lists.
+func withoutEmptyList(value interface{}) interface{} {
+	if items, ok := value.([]interface{}); ok && len(items) == 0 {
+		return nil
+	}
+
+	return value
+}
+
+// missingItems gets the items that aren't in the other list.
+func missingItems(items []interface{}, other []interface{}) []interface{} {
+	missing := make([]interface{}, 0)
+	for _, item := range items {
+		found := false
+		for _, o := range other {
+			if equalJSON(item, o) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, item)
+		}
+	}
+
+	return missing
+}
@@ -0,0 +1,92 @@
+package flags_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ldcli/internal/flags"
+)
+
+func TestDiffEnvironments(t *testing.T) {
+	var from, to map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"on": true,
+		"offVariation": 1,
+		"fallthrough": {"variation": 0},
+		"targets": [{"values": ["user-1"], "variation": 0, "contextKind": "user"}],
+		"contextTargets": [{"values": [], "variation": 0, "contextKind": "user"}],
+		"rules": [
+			{"_id": "a", "variation": 0, "clauses": [{"_id": "b", "attribute": "country", "op": "in", "values": ["CA"]}]},
+			{"_id": "c", "variation": 1, "clauses": [{"_id": "d", "attribute": "country", "op": "in", "values": ["US"]}]}
+		],
+		"version": 3
+	}`), &from))
+
+	t.Run("with the same targeting", func(t *testing.T) {
+		require.NoError(t, json.Unmarshal([]byte(`{
+			"on": true,
+			"offVariation": 1,
+			"fallthrough": {"variation": 0},
+			"targets": [{"values": ["user-1"], "variation": 0, "contextKind": "user"}],
+			"rules": [
+				{"_id": "e", "variation": 0, "clauses": [{"_id": "f", "attribute": "country", "op": "in", "values": ["CA"]}]},
+				{"_id": "g", "variation": 1, "clauses": [{"_id": "h", "attribute": "country", "op": "in", "values": ["US"]}]}
+			],
+			"version": 10
+		}`), &to))
+
+		assert.Empty(t, flags.DiffEnvironments(from, to))
+	})
+
+	t.Run("with different targeting", func(t *testing.T) {
+		require.NoError(t, json.Unmarshal([]byte(`{
+			"on": false,
+			"offVariation": 1,
+			"fallthrough": {"variation": 0},
+			"prerequisites": [{"key": "other-flag", "variation": 0}],
+			"targets": [{"values": ["user-1"], "variation": 0, "contextKind": "user"}],
+			"rules": [
+				{"_id": "e", "variation": 0, "clauses": [{"_id": "f", "attribute": "country", "op": "in", "values": ["MX"]}]},
+				{"_id": "g", "variation": 1, "clauses": [{"_id": "h", "attribute": "country", "op": "in", "values": ["US"]}]}
+			]
+		}`), &to))
+
+		diffs := flags.DiffEnvironments(from, to)
+
+		require.Len(t, diffs, 3)
+		assert.Equal(t, "on", diffs[0].Setting)
+		assert.Equal(t, true, diffs[0].From)
+		assert.Equal(t, false, diffs[0].To)
+		assert.Equal(t, "prerequisites", diffs[1].Setting)
+		assert.Equal(t, []interface{}{map[string]interface{}{"key": "other-flag", "variation": float64(0)}}, diffs[1].Added)
+		assert.Empty(t, diffs[1].Removed)
+		assert.Equal(t, "rules", diffs[2].Setting)
+		assert.Len(t, diffs[2].Added, 1)
+		assert.Len(t, diffs[2].Removed, 1)
+		assert.NotContains(t, diffs[2].Added[0], "_id")
+	})
+}
+
+func TestEnvironmentConfig(t *testing.T) {
+	flag := map[string]interface{}{
+		"environments": map[string]interface{}{
+			"production": map[string]interface{}{"on": true},
+		},
+	}
+
+	t.Run("with an environment", func(t *testing.T) {
+		env, err := flags.EnvironmentConfig(flag, "production")
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"on": true}, env)
+	})
+
+	t.Run("with an environment that doesn't exist", func(t *testing.T) {
+		_, err := flags.EnvironmentConfig(flag, "staging")
+
+		assert.EqualError(t, err, "environment staging doesn't exist")
+	})
+}
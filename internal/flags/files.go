@@ -0,0 +1,330 @@
+package flags
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"ldcli/internal/errors"
+)
+
+const (
+	FileFormatJSON = "json"
+	FileFormatYAML = "yaml"
+)
+
+// FlagFile is the part of a flag that can be kept in a file and applied to the flag. Every setting
+// is optional, and a setting that's left out isn't changed when the file is applied.
+type FlagFile struct {
+	Key                    string                     `json:"key" yaml:"key"`
+	Name                   interface{}                `json:"name,omitempty" yaml:"name,omitempty"`
+	Description            interface{}                `json:"description,omitempty" yaml:"description,omitempty"`
+	Temporary              interface{}                `json:"temporary,omitempty" yaml:"temporary,omitempty"`
+	Tags                   interface{}                `json:"tags,omitempty" yaml:"tags,omitempty"`
+	ClientSideAvailability interface{}                `json:"clientSideAvailability,omitempty" yaml:"clientSideAvailability,omitempty"`
+	CustomProperties       interface{}                `json:"customProperties,omitempty" yaml:"customProperties,omitempty"`
+	Variations             interface{}                `json:"variations,omitempty" yaml:"variations,omitempty"`
+	Environments           map[string]EnvironmentFile `json:"environments,omitempty" yaml:"environments,omitempty"`
+}
+
+// EnvironmentFile is the targeting for a flag in an environment.
+type EnvironmentFile struct {
+	On                     interface{} `json:"on,omitempty" yaml:"on,omitempty"`
+	OffVariation           interface{} `json:"offVariation,omitempty" yaml:"offVariation,omitempty"`
+	Fallthrough            interface{} `json:"fallthrough,omitempty" yaml:"fallthrough,omitempty"`
+	Prerequisites          interface{} `json:"prerequisites,omitempty" yaml:"prerequisites,omitempty"`
+	Targets                interface{} `json:"targets,omitempty" yaml:"targets,omitempty"`
+	ContextTargets         interface{} `json:"contextTargets,omitempty" yaml:"contextTargets,omitempty"`
+	Rules                  interface{} `json:"rules,omitempty" yaml:"rules,omitempty"`
+	TrackEvents            interface{} `json:"trackEvents,omitempty" yaml:"trackEvents,omitempty"`
+	TrackEventsFallthrough interface{} `json:"trackEventsFallthrough,omitempty" yaml:"trackEventsFallthrough,omitempty"`
+}
+
+// setting is the path and value of a setting in a flag file.
+type setting struct {
+	path  string
+	value interface{}
+}
+
+func (f FlagFile) settings() []setting {
+	return []setting{
+		{path: "name", value: f.Name},
+		{path: "description", value: f.Description},
+		{path: "temporary", value: f.Temporary},
+		{path: "tags", value: f.Tags},
+		{path: "clientSideAvailability", value: f.ClientSideAvailability},
+		{path: "customProperties", value: f.CustomProperties},
+	}
+}
+
+func (e EnvironmentFile) settings() []setting {
+	return []setting{
+		{path: "on", value: e.On},
+		{path: "offVariation", value: e.OffVariation},
+		{path: "fallthrough", value: e.Fallthrough},
+		{path: "prerequisites", value: e.Prerequisites},
+		{path: "targets", value: e.Targets},
+		{path: "contextTargets", value: e.ContextTargets},
+		{path: "rules", value: e.Rules},
+		{path: "trackEvents", value: e.TrackEvents},
+		{path: "trackEventsFallthrough", value: e.TrackEventsFallthrough},
+	}
+}
+
+// NewFlagFile gets the settings to keep in a file from a flag in an API response. It leaves out
+// read-only fields and the IDs the API generates for variations, rules, and clauses.
+func NewFlagFile(flag map[string]interface{}) FlagFile {
+	flag, _ = withIntegers(flag).(map[string]interface{})
+	key, _ := flag["key"].(string)
+	f := FlagFile{
+		Key:                    key,
+		Name:                   flag["name"],
+		Description:            flag["description"],
+		Temporary:              flag["temporary"],
+		Tags:                   flag["tags"],
+		ClientSideAvailability: flag["clientSideAvailability"],
+		CustomProperties:       flag["customProperties"],
+		Variations:             withoutIDs(flag["variations"]),
+	}
+
+	environments, _ := flag["environments"].(map[string]interface{})
+	if len(environments) > 0 {
+		f.Environments = make(map[string]EnvironmentFile, len(environments))
+	}
+	for envKey, e := range environments {
+		env, _ := e.(map[string]interface{})
+		f.Environments[envKey] = EnvironmentFile{
+			On:                     env["on"],
+			OffVariation:           env["offVariation"],
+			Fallthrough:            env["fallthrough"],
+			Prerequisites:          env["prerequisites"],
+			Targets:                env["targets"],
+			ContextTargets:         withoutUserContextTargets(env["contextTargets"]),
+			Rules:                  withoutIDs(env["rules"]),
+			TrackEvents:            env["trackEvents"],
+			TrackEventsFallthrough: env["trackEventsFallthrough"],
+		}
+	}
+
+	return f
+}
+
+// ProjectReferences lists the prerequisite flags and segments the flag's targeting refers to, such
+// as "prerequisite flag other-flag in production". They only exist in the flag's own project.
+func ProjectReferences(f FlagFile) []string {
+	envKeys := make([]string, 0, len(f.Environments))
+	for envKey := range f.Environments {
+		envKeys = append(envKeys, envKey)
+	}
+	sort.Strings(envKeys)
+
+	references := make([]string, 0)
+	for _, envKey := range envKeys {
+		env := f.Environments[envKey]
+		prerequisites, _ := env.Prerequisites.([]interface{})
+		for _, p := range prerequisites {
+			prerequisite, _ := p.(map[string]interface{})
+			references = append(references, fmt.Sprintf("prerequisite flag %v in %s", prerequisite["key"], envKey))
+		}
+
+		seen := make(map[string]bool)
+		rules, _ := env.Rules.([]interface{})
+		for _, r := range rules {
+			rule, _ := r.(map[string]interface{})
+			clauses, _ := rule["clauses"].([]interface{})
+			for _, c := range clauses {
+				clause, _ := c.(map[string]interface{})
+				if clause["op"] != "segmentMatch" {
+					continue
+				}
+				segments, _ := clause["values"].([]interface{})
+				for _, s := range segments {
+					segmentKey := fmt.Sprint(s)
+					if !seen[segmentKey] {
+						seen[segmentKey] = true
+						references = append(references, fmt.Sprintf("segment %s in %s", segmentKey, envKey))
+					}
+				}
+			}
+		}
+	}
+
+	return references
+}
+
+// MarshalFlagFile formats the flag file as JSON or YAML.
+func MarshalFlagFile(f FlagFile, format string) ([]byte, error) {
+	switch format {
+	case FileFormatJSON:
+		data, err := json.MarshalIndent(f, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+
+		return append(data, '\n'), nil
+	case FileFormatYAML:
+		var out bytes.Buffer
+		encoder := yaml.NewEncoder(&out)
+		encoder.SetIndent(2)
+		err := encoder.Encode(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return out.Bytes(), nil
+	default:
+		return nil, errors.NewError(fmt.Sprintf("format must be %s or %s", FileFormatJSON, FileFormatYAML))
+	}
+}
+
+// FlagFilePath gets the path of the file for a flag in the directory.
+func FlagFilePath(dir string, key string, format string) string {
+	extension := ".yml"
+	if format == FileFormatJSON {
+		extension = ".json"
+	}
+
+	return filepath.Join(dir, key+extension)
+}
+
+// ReadFlagFiles reads every JSON and YAML flag file in the directory, sorted by file name.
+func ReadFlagFiles(dir string) ([]FlagFile, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		switch strings.ToLower(filepath.Ext(entry.Name())) {
+		case ".json", ".yaml", ".yml":
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	files := make([]FlagFile, 0, len(names))
+	keys := make(map[string]string, len(names))
+	for _, name := range names {
+		f, err := readFlagFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		if other, ok := keys[f.Key]; ok {
+			return nil, errors.NewError(fmt.Sprintf("%s and %s are both for flag %s", other, name, f.Key))
+		}
+		keys[f.Key] = name
+		files = append(files, f)
+	}
+
+	return files, nil
+}
+
+func readFlagFile(path string) (FlagFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return FlagFile{}, err
+	}
+
+	var f FlagFile
+	if strings.ToLower(filepath.Ext(path)) == ".json" {
+		err = json.Unmarshal(data, &f)
+	} else {
+		err = yaml.Unmarshal(data, &f)
+	}
+	if err != nil {
+		return FlagFile{}, errors.NewError(fmt.Sprintf("%s is invalid: %s", path, err))
+	}
+	if f.Key == "" {
+		return FlagFile{}, errors.NewError(fmt.Sprintf("%s is missing the flag key", path))
+	}
+
+	return f, nil
+}
+
+// withoutIDs removes the _id fields the API generates from a list of variations or rules and the
+// clauses in each rule. The API generates new IDs for new variations and rules, so the IDs can't
+// be kept in files.
+func withoutIDs(value interface{}) interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		items := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			items = append(items, withoutIDs(item))
+		}
+
+		return items
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			if k == "_id" {
+				continue
+			}
+			m[k] = withoutIDs(item)
+		}
+
+		return m
+	default:
+		return value
+	}
+}
+
+// withIntegers changes whole numbers from JSON to integers so they aren't formatted as floats, such
+// as 1e+06, in YAML.
+func withIntegers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		items := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			items = append(items, withIntegers(item))
+		}
+
+		return items
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			m[k] = withIntegers(item)
+		}
+
+		return m
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < 1<<53 {
+			return int64(v)
+		}
+
+		return v
+	default:
+		return value
+	}
+}
+
+// withoutUserContextTargets removes the context targets for users without any values. The API
+// adds one for each of the targets, which are for users, to keep their order.
+func withoutUserContextTargets(value interface{}) interface{} {
+	targets, ok := value.([]interface{})
+	if !ok {
+		return value
+	}
+
+	filtered := make([]interface{}, 0, len(targets))
+	for _, t := range targets {
+		target, _ := t.(map[string]interface{})
+		values, _ := target["values"].([]interface{})
+		if target["contextKind"] == "user" && len(values) == 0 {
+			continue
+		}
+		filtered = append(filtered, t)
+	}
+
+	return filtered
+}
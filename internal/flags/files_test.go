@@ -0,0 +1,202 @@
+package flags_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ldcli/internal/flags"
+)
+
+const testFlag = `{
+	"_links": {"self": {"href": "/api/v2/flags/test-proj/test-flag"}},
+	"key": "test-flag",
+	"name": "test flag",
+	"creationDate": 1700000000000,
+	"temporary": true,
+	"tags": ["beta"],
+	"variations": [
+		{"_id": "a", "value": true, "name": "on"},
+		{"_id": "b", "value": false}
+	],
+	"environments": {
+		"production": {
+			"on": true,
+			"offVariation": 1,
+			"fallthrough": {"variation": 0},
+			"targets": [{"values": ["user-1"], "variation": 0, "contextKind": "user"}],
+			"contextTargets": [
+				{"values": [], "variation": 0, "contextKind": "user"},
+				{"values": ["org-1"], "variation": 1, "contextKind": "org"}
+			],
+			"rules": [
+				{
+					"_id": "rule-1",
+					"variation": 1,
+					"clauses": [{"_id": "clause-1", "attribute": "country", "op": "in", "values": ["CA"]}]
+				}
+			],
+			"_site": {"href": "/test-proj/production/features/test-flag"},
+			"version": 12
+		}
+	}
+}`
+
+func TestNewFlagFile(t *testing.T) {
+	var flag map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(testFlag), &flag))
+
+	f := flags.NewFlagFile(flag)
+
+	data, err := flags.MarshalFlagFile(f, flags.FileFormatYAML)
+	require.NoError(t, err)
+	assert.Equal(t, `key: test-flag
+name: test flag
+temporary: true
+tags:
+  - beta
+variations:
+  - name: "on"
+    value: true
+  - value: false
+environments:
+  production:
+    "on": true
+    offVariation: 1
+    fallthrough:
+      variation: 0
+    targets:
+      - contextKind: user
+        values:
+          - user-1
+        variation: 0
+    contextTargets:
+      - contextKind: org
+        values:
+          - org-1
+        variation: 1
+    rules:
+      - clauses:
+          - attribute: country
+            op: in
+            values:
+              - CA
+        variation: 1
+`, string(data))
+}
+
+func TestProjectReferences(t *testing.T) {
+	t.Run("lists prerequisites and segments", func(t *testing.T) {
+		var flag map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(`{
+			"key": "test-flag",
+			"environments": {
+				"qa": {
+					"rules": [
+						{"clauses": [{"attribute": "segmentMatch", "op": "segmentMatch", "values": ["beta-users"]}]},
+						{"clauses": [{"attribute": "segmentMatch", "op": "segmentMatch", "values": ["beta-users", "staff"]}]}
+					]
+				},
+				"production": {"prerequisites": [{"key": "other-flag", "variation": 0}]}
+			}
+		}`), &flag))
+
+		references := flags.ProjectReferences(flags.NewFlagFile(flag))
+
+		assert.Equal(t, []string{
+			"prerequisite flag other-flag in production",
+			"segment beta-users in qa",
+			"segment staff in qa",
+		}, references)
+	})
+
+	t.Run("without prerequisites or segments", func(t *testing.T) {
+		var flag map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(testFlag), &flag))
+
+		references := flags.ProjectReferences(flags.NewFlagFile(flag))
+
+		assert.Empty(t, references)
+	})
+}
+
+func TestMarshalFlagFile(t *testing.T) {
+	f := flags.FlagFile{
+		Key:  "test-flag",
+		Tags: []interface{}{"beta"},
+		Environments: map[string]flags.EnvironmentFile{
+			"production": {On: false},
+		},
+	}
+
+	t.Run("with json", func(t *testing.T) {
+		data, err := flags.MarshalFlagFile(f, flags.FileFormatJSON)
+
+		require.NoError(t, err)
+		assert.Equal(t, `{
+  "key": "test-flag",
+  "tags": [
+    "beta"
+  ],
+  "environments": {
+    "production": {
+      "on": false
+    }
+  }
+}
+`, string(data))
+	})
+
+	t.Run("with an invalid format", func(t *testing.T) {
+		_, err := flags.MarshalFlagFile(f, "xml")
+
+		assert.EqualError(t, err, "format must be json or yaml")
+	})
+}
+
+func TestFlagFilePath(t *testing.T) {
+	assert.Equal(t, filepath.Join("flags", "test-flag.yml"), flags.FlagFilePath("flags", "test-flag", flags.FileFormatYAML))
+	assert.Equal(t, filepath.Join("flags", "test-flag.json"), flags.FlagFilePath("flags", "test-flag", flags.FileFormatJSON))
+}
+
+func TestReadFlagFiles(t *testing.T) {
+	t.Run("reads json and yaml files", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "b-flag.json"), []byte(`{"key": "b-flag", "tags": ["beta"]}`), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "a-flag.yml"), []byte("key: a-flag\nenvironments:\n  production:\n    on: true\n"), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("# flags"), 0644))
+		require.NoError(t, os.Mkdir(filepath.Join(dir, "archived"), 0755))
+
+		files, err := flags.ReadFlagFiles(dir)
+
+		require.NoError(t, err)
+		require.Len(t, files, 2)
+		assert.Equal(t, "a-flag", files[0].Key)
+		assert.Equal(t, true, files[0].Environments["production"].On)
+		assert.Equal(t, "b-flag", files[1].Key)
+		assert.Equal(t, []interface{}{"beta"}, files[1].Tags)
+	})
+
+	t.Run("with a file without a key", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "test-flag.yml"), []byte("name: test flag\n"), 0644))
+
+		_, err := flags.ReadFlagFiles(dir)
+
+		assert.EqualError(t, err, filepath.Join(dir, "test-flag.yml")+" is missing the flag key")
+	})
+
+	t.Run("with two files for the same flag", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "test-flag.json"), []byte(`{"key": "test-flag"}`), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "test-flag.yml"), []byte("key: test-flag\n"), 0644))
+
+		_, err := flags.ReadFlagFiles(dir)
+
+		assert.EqualError(t, err, "test-flag.json and test-flag.yml are both for flag test-flag")
+	})
+}
@@ -0,0 +1,160 @@
+package flags
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"ldcli/internal/errors"
+)
+
+// BuildPatch gets the JSON Patch operations that change the flag to match the flag file. It only
+// changes the settings that are in the file and are different from the flag.
+func BuildPatch(flag map[string]interface{}, file FlagFile) ([]UpdateInput, error) {
+	patch := make([]UpdateInput, 0)
+	patch = append(patch, variationsPatch(flag["variations"], file.Variations)...)
+	for _, s := range file.settings() {
+		patch = append(patch, settingPatch(flag, "", s)...)
+	}
+
+	environments, _ := flag["environments"].(map[string]interface{})
+	envKeys := make([]string, 0, len(file.Environments))
+	for envKey := range file.Environments {
+		envKeys = append(envKeys, envKey)
+	}
+	sort.Strings(envKeys)
+	for _, envKey := range envKeys {
+		env, ok := environments[envKey].(map[string]interface{})
+		if !ok {
+			return nil, errors.NewError(fmt.Sprintf("environment %s doesn't exist", envKey))
+		}
+		for _, s := range file.Environments[envKey].settings() {
+			patch = append(patch, settingPatch(env, "/environments/"+escapePath(envKey), s)...)
+		}
+	}
+
+	return patch, nil
+}
+
+// NewFlagBody gets the request body to create a flag from the flag file. The targeting in each
+// environment is applied after the flag is created.
+func NewFlagBody(file FlagFile) map[string]interface{} {
+	body := map[string]interface{}{
+		"key":  file.Key,
+		"name": file.Key,
+	}
+	for _, s := range file.settings() {
+		if s.value != nil {
+			body[s.path] = s.value
+		}
+	}
+	if file.Variations != nil {
+		body["variations"] = file.Variations
+	}
+
+	return body
+}
+
+func settingPatch(current map[string]interface{}, parentPath string, s setting) []UpdateInput {
+	if s.value == nil {
+		return nil
+	}
+
+	currentValue, exists := current[s.path]
+	if equalJSON(normalizeSetting(s.path, currentValue), normalizeSetting(s.path, s.value)) {
+		return nil
+	}
+
+	op := "replace"
+	if !exists {
+		op = "add"
+	}
+
+	return []UpdateInput{{Op: op, Path: parentPath + "/" + s.path, Value: s.value}}
+}
+
+// normalizeSetting removes the parts of a setting the API generates so only the parts that can be
+// set are compared.
+func normalizeSetting(path string, value interface{}) interface{} {
+	switch path {
+	case "rules":
+		return withoutIDs(value)
+	case "contextTargets":
+		return withoutUserContextTargets(value)
+	default:
+		return value
+	}
+}
+
+// variationsPatch changes each field of the variations that are different, instead of replacing
+// all of them, so the API keeps the variations' IDs. It adds or removes variations at the end if
+// the number of variations changed.
+func variationsPatch(current interface{}, desired interface{}) []UpdateInput {
+	desiredVariations, ok := desired.([]interface{})
+	if !ok {
+		return nil
+	}
+	currentVariations, _ := current.([]interface{})
+
+	patch := make([]UpdateInput, 0)
+	for i, d := range desiredVariations {
+		if i >= len(currentVariations) {
+			patch = append(patch, UpdateInput{Op: "add", Path: "/variations/-", Value: d})
+			continue
+		}
+
+		desiredVariation, _ := d.(map[string]interface{})
+		currentVariation, _ := currentVariations[i].(map[string]interface{})
+		fields := make([]string, 0, len(desiredVariation))
+		for field := range desiredVariation {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		for _, field := range fields {
+			currentValue, exists := currentVariation[field]
+			if equalJSON(currentValue, desiredVariation[field]) {
+				continue
+			}
+			op := "replace"
+			if !exists {
+				op = "add"
+			}
+			patch = append(patch, UpdateInput{
+				Op:    op,
+				Path:  fmt.Sprintf("/variations/%d/%s", i, escapePath(field)),
+				Value: desiredVariation[field],
+			})
+		}
+	}
+	// remove from the end so the indexes of the other variations don't change
+	for i := len(currentVariations) - 1; i >= len(desiredVariations); i-- {
+		patch = append(patch, UpdateInput{Op: "remove", Path: fmt.Sprintf("/variations/%d", i)})
+	}
+
+	return patch
+}
+
+// equalJSON checks if the values are the same in JSON. Values from YAML and JSON files have
+// different types for numbers and maps, so they're compared after converting them to JSON.
+func equalJSON(a interface{}, b interface{}) bool {
+	return reflect.DeepEqual(normalizeJSON(a), normalizeJSON(b))
+}
+
+func normalizeJSON(value interface{}) interface{} {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+
+	var normalized interface{}
+	_ = json.Unmarshal(data, &normalized)
+
+	return normalized
+}
+
+// escapePath escapes a key to use it in a JSON Pointer path.
+func escapePath(key string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+}
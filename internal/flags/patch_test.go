@@ -0,0 +1,106 @@
+package flags_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"ldcli/internal/flags"
+)
+
+func TestBuildPatch(t *testing.T) {
+	var flag map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(testFlag), &flag))
+
+	t.Run("without changes", func(t *testing.T) {
+		patch, err := flags.BuildPatch(flag, flags.NewFlagFile(flag))
+
+		require.NoError(t, err)
+		assert.Empty(t, patch)
+	})
+
+	t.Run("with changes from a yaml file", func(t *testing.T) {
+		var file flags.FlagFile
+		require.NoError(t, yaml.Unmarshal([]byte(`key: test-flag
+description: a test flag
+tags: [beta]
+variations:
+  - value: true
+    name: enabled
+  - value: false
+  - value: false
+    name: also off
+environments:
+  production:
+    on: false
+    offVariation: 1
+    rules:
+      - variation: 0
+        clauses:
+          - attribute: country
+            op: in
+            values: [CA]
+`), &file))
+
+		patch, err := flags.BuildPatch(flag, file)
+
+		require.NoError(t, err)
+		assert.Equal(t, []flags.UpdateInput{
+			{Op: "replace", Path: "/variations/0/name", Value: "enabled"},
+			{Op: "add", Path: "/variations/-", Value: map[string]interface{}{"value": false, "name": "also off"}},
+			{Op: "add", Path: "/description", Value: "a test flag"},
+			{Op: "replace", Path: "/environments/production/on", Value: false},
+			{Op: "replace", Path: "/environments/production/rules", Value: file.Environments["production"].Rules},
+		}, patch)
+	})
+
+	t.Run("with fewer variations", func(t *testing.T) {
+		file := flags.FlagFile{
+			Key:        "test-flag",
+			Variations: []interface{}{map[string]interface{}{"value": true}},
+		}
+
+		patch, err := flags.BuildPatch(flag, file)
+
+		require.NoError(t, err)
+		assert.Equal(t, []flags.UpdateInput{
+			{Op: "remove", Path: "/variations/1"},
+		}, patch)
+	})
+
+	t.Run("with an environment that doesn't exist", func(t *testing.T) {
+		file := flags.FlagFile{
+			Key: "test-flag",
+			Environments: map[string]flags.EnvironmentFile{
+				"staging": {On: true},
+			},
+		}
+
+		_, err := flags.BuildPatch(flag, file)
+
+		assert.EqualError(t, err, "environment staging doesn't exist")
+	})
+}
+
+func TestNewFlagBody(t *testing.T) {
+	file := flags.FlagFile{
+		Key:        "test-flag",
+		Tags:       []interface{}{"beta"},
+		Variations: []interface{}{map[string]interface{}{"value": "a"}},
+		Environments: map[string]flags.EnvironmentFile{
+			"production": {On: true},
+		},
+	}
+
+	body := flags.NewFlagBody(file)
+
+	assert.Equal(t, map[string]interface{}{
+		"key":        "test-flag",
+		"name":       "test-flag",
+		"tags":       []interface{}{"beta"},
+		"variations": []interface{}{map[string]interface{}{"value": "a"}},
+	}, body)
+}
@@ -0,0 +1,86 @@
+package flags
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"ldcli/internal/errors"
+)
+
+// The parts of a flag's targeting that can be promoted from one environment to another.
+const (
+	PromoteFallthrough = "fallthrough"
+	PromoteOn          = "on"
+	PromoteRules       = "rules"
+	PromoteTargets     = "targets"
+)
+
+// promoteSettings are the targeting settings for each part that can be promoted, in the order
+// they're changed.
+var promoteSettings = map[string][]string{
+	PromoteOn:          {"on"},
+	PromoteFallthrough: {"fallthrough"},
+	PromoteTargets:     {"targets", "contextTargets"},
+	PromoteRules:       {"rules"},
+}
+
+// PromoteIncludes are the parts of a flag's targeting that are promoted by default.
+var PromoteIncludes = []string{PromoteOn, PromoteFallthrough, PromoteTargets, PromoteRules}
+
+// ValidatePromoteIncludes checks that each part to promote is one that can be promoted.
+func ValidatePromoteIncludes(includes []string) error {
+	for _, include := range includes {
+		if _, ok := promoteSettings[include]; !ok {
+			valid := make([]string, 0, len(promoteSettings))
+			for k := range promoteSettings {
+				valid = append(valid, k)
+			}
+			sort.Strings(valid)
+
+			return errors.NewError(fmt.Sprintf(
+				"%s can't be promoted. Use one or more of %s",
+				include,
+				strings.Join(valid, ", "),
+			))
+		}
+	}
+
+	return nil
+}
+
+// BuildPromotePatch gets the JSON Patch operations that copy the included parts of a flag's
+// targeting in one environment to another environment. Settings that are already the same aren't
+// changed.
+func BuildPromotePatch(from map[string]interface{}, to map[string]interface{}, toEnvKey string, includes []string) []UpdateInput {
+	included := make(map[string]bool, len(includes))
+	for _, include := range includes {
+		included[include] = true
+	}
+
+	patch := make([]UpdateInput, 0)
+	for _, include := range PromoteIncludes {
+		if !included[include] {
+			continue
+		}
+		for _, path := range promoteSettings[include] {
+			fromValue := withoutEmptyList(normalizeSetting(path, from[path]))
+			toValue := withoutEmptyList(normalizeSetting(path, to[path]))
+			if equalJSON(fromValue, toValue) {
+				continue
+			}
+
+			// the API generates new IDs for the rules in the other environment
+			value := from[path]
+			if path == "rules" {
+				value = withoutIDs(value)
+			}
+			if value == nil && listSettings[path] {
+				value = []interface{}{}
+			}
+			patch = append(patch, settingPatch(to, "/environments/"+escapePath(toEnvKey), setting{path: path, value: value})...)
+		}
+	}
+
+	return patch
+}
@@ -0,0 +1,77 @@
+package flags_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ldcli/internal/flags"
+)
+
+func TestBuildPromotePatch(t *testing.T) {
+	var from, to map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"on": true,
+		"offVariation": 0,
+		"fallthrough": {"variation": 0},
+		"targets": [{"values": ["user-1"], "variation": 0, "contextKind": "user"}],
+		"contextTargets": [{"values": [], "variation": 0, "contextKind": "user"}],
+		"rules": [{"_id": "a", "variation": 0, "clauses": [{"_id": "b", "attribute": "country", "op": "in", "values": ["CA"]}]}]
+	}`), &from))
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"on": false,
+		"offVariation": 1,
+		"fallthrough": {"variation": 0},
+		"targets": [],
+		"rules": [{"_id": "c", "variation": 1, "clauses": []}]
+	}`), &to))
+
+	t.Run("with every part", func(t *testing.T) {
+		patch := flags.BuildPromotePatch(from, to, "production", flags.PromoteIncludes)
+
+		assert.Equal(t, []flags.UpdateInput{
+			{Op: "replace", Path: "/environments/production/on", Value: true},
+			{Op: "replace", Path: "/environments/production/targets", Value: from["targets"]},
+			{Op: "replace", Path: "/environments/production/rules", Value: []interface{}{
+				map[string]interface{}{
+					"variation": float64(0),
+					"clauses": []interface{}{
+						map[string]interface{}{"attribute": "country", "op": "in", "values": []interface{}{"CA"}},
+					},
+				},
+			}},
+		}, patch)
+	})
+
+	t.Run("with only rules", func(t *testing.T) {
+		patch := flags.BuildPromotePatch(from, to, "production", []string{flags.PromoteRules})
+
+		require.Len(t, patch, 1)
+		assert.Equal(t, "/environments/production/rules", patch[0].Path)
+	})
+
+	t.Run("with the same targeting", func(t *testing.T) {
+		patch := flags.BuildPromotePatch(from, from, "production", flags.PromoteIncludes)
+
+		assert.Empty(t, patch)
+	})
+
+	t.Run("removes targets that aren't in the other environment", func(t *testing.T) {
+		patch := flags.BuildPromotePatch(to, from, "staging", []string{flags.PromoteTargets})
+
+		assert.Equal(t, []flags.UpdateInput{
+			{Op: "replace", Path: "/environments/staging/targets", Value: []interface{}{}},
+		}, patch)
+	})
+}
+
+func TestValidatePromoteIncludes(t *testing.T) {
+	assert.NoError(t, flags.ValidatePromoteIncludes([]string{"rules", "targets"}))
+	assert.EqualError(
+		t,
+		flags.ValidatePromoteIncludes([]string{"rules", "prerequisites"}),
+		"prerequisites can't be promoted. Use one or more of fallthrough, on, rules, targets",
+	)
+}
@@ -0,0 +1,182 @@
+package flags
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"ldcli/internal/errors"
+)
+
+// The lifecycle stages of a flag, from the newest to the stalest.
+const (
+	StageNew       = "new"
+	StageActive    = "active"
+	StageLaunched  = "launched"
+	StageInactive  = "inactive"
+	StageRemovable = "removable"
+)
+
+// stageOrder sorts flags in a report so the stalest ones are first.
+var stageOrder = map[string]int{
+	StageRemovable: 0,
+	StageInactive:  1,
+	StageLaunched:  2,
+	StageActive:    3,
+	StageNew:       4,
+}
+
+// The flag statuses from the API.
+const (
+	statusActive   = "active"
+	statusInactive = "inactive"
+	statusLaunched = "launched"
+	statusNew      = "new"
+)
+
+// FlagStatus is a flag's status in one environment and the last time it was evaluated there.
+type FlagStatus struct {
+	Name          string
+	LastRequested *time.Time
+}
+
+// FlagReport is a flag's lifecycle stage along with the data it's based on. The code references
+// are nil if they aren't available.
+type FlagReport struct {
+	Key            string            `json:"key"`
+	Name           string            `json:"name"`
+	Stage          string            `json:"stage"`
+	Temporary      bool              `json:"temporary"`
+	CreationDate   int64             `json:"creationDate"`
+	LastEvaluated  *int64            `json:"lastEvaluated"`
+	CodeReferences *int              `json:"codeReferences"`
+	Statuses       map[string]string `json:"statuses"`
+}
+
+// StaleReport is the lifecycle stage of each flag in a project created before a date.
+type StaleReport struct {
+	Flags      []FlagReport `json:"flags"`
+	TotalCount int          `json:"totalCount"`
+	Warnings   []string     `json:"warnings,omitempty"`
+}
+
+// NewStaleReport gets the lifecycle stage of each flag created before the date from the flag's
+// status in each environment and its code references. Without code references, flags are
+// inactive instead of removable since there's no way to know if they're still in the code.
+func NewStaleReport(
+	flags []map[string]interface{},
+	statuses map[string]map[string]FlagStatus,
+	codeReferences map[string]int,
+	createdBefore time.Time,
+) StaleReport {
+	report := StaleReport{
+		Flags:      make([]FlagReport, 0, len(flags)),
+		TotalCount: len(flags),
+	}
+	if codeReferences == nil {
+		report.Warnings = append(
+			report.Warnings,
+			"code references aren't available for the project, so no flags are removable",
+		)
+	}
+
+	for _, flag := range flags {
+		creationDate, _ := flag["creationDate"].(float64)
+		if !time.UnixMilli(int64(creationDate)).Before(createdBefore) {
+			continue
+		}
+
+		key, _ := flag["key"].(string)
+		name, _ := flag["name"].(string)
+		temporary, _ := flag["temporary"].(bool)
+		r := FlagReport{
+			Key:          key,
+			Name:         name,
+			Temporary:    temporary,
+			CreationDate: int64(creationDate),
+			Statuses:     make(map[string]string),
+		}
+		for envKey, envStatuses := range statuses {
+			status, ok := envStatuses[key]
+			if !ok {
+				continue
+			}
+			r.Statuses[envKey] = status.Name
+			if status.LastRequested != nil {
+				lastRequested := status.LastRequested.UnixMilli()
+				if r.LastEvaluated == nil || lastRequested > *r.LastEvaluated {
+					r.LastEvaluated = &lastRequested
+				}
+			}
+		}
+		if codeReferences != nil {
+			count := codeReferences[key]
+			r.CodeReferences = &count
+		}
+		r.Stage = flagStage(r)
+
+		report.Flags = append(report.Flags, r)
+	}
+
+	sort.SliceStable(report.Flags, func(i, j int) bool {
+		a, b := report.Flags[i], report.Flags[j]
+		if a.Stage != b.Stage {
+			return stageOrder[a.Stage] < stageOrder[b.Stage]
+		}
+
+		return a.Key < b.Key
+	})
+
+	return report
+}
+
+// flagStage gets a flag's stage from its most active status in any environment. An inactive
+// temporary flag without code references can be removed.
+func flagStage(r FlagReport) string {
+	has := make(map[string]bool)
+	for _, status := range r.Statuses {
+		has[status] = true
+	}
+
+	switch {
+	case has[statusActive]:
+		return StageActive
+	case has[statusLaunched]:
+		return StageLaunched
+	case has[statusNew] && !has[statusInactive]:
+		return StageNew
+	case r.Temporary && r.CodeReferences != nil && *r.CodeReferences == 0:
+		return StageRemovable
+	default:
+		return StageInactive
+	}
+}
+
+// ParseAge parses how long ago something was, such as 90d. It supports days and weeks along with
+// the units for durations, such as 12h.
+func ParseAge(value string) (time.Duration, error) {
+	var unit time.Duration
+	switch {
+	case strings.HasSuffix(value, "d"):
+		unit = 24 * time.Hour
+	case strings.HasSuffix(value, "w"):
+		unit = 7 * 24 * time.Hour
+	}
+	if unit != 0 {
+		n, err := strconv.Atoi(value[:len(value)-1])
+		if err != nil || n < 0 {
+			return 0, errors.NewError(fmt.Sprintf("%s isn't a valid age. Use a number of days, such as 90d", value))
+		}
+
+		return time.Duration(n) * unit, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, errors.NewError(fmt.Sprintf("%s isn't a valid age. Use a number of days, such as 90d", value))
+	}
+
+	return d, nil
+}
@@ -0,0 +1,203 @@
+package flags_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ldcli/internal/flags"
+)
+
+func TestNewStaleReport(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	lastRequested := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	earlierRequested := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	flag := func(key string, temporary bool) map[string]interface{} {
+		return map[string]interface{}{
+			"key":          key,
+			"name":         key + " name",
+			"temporary":    temporary,
+			"creationDate": float64(created.UnixMilli()),
+		}
+	}
+
+	tests := map[string]struct {
+		temporary      bool
+		statuses       map[string]string
+		codeReferences map[string]int
+		expectedStage  string
+	}{
+		"with a status that's active in one environment": {
+			statuses:       map[string]string{"production": "inactive", "staging": "active"},
+			codeReferences: map[string]int{"test-flag": 2},
+			expectedStage:  flags.StageActive,
+		},
+		"with a status that's launched in one environment": {
+			statuses:       map[string]string{"production": "launched", "staging": "inactive"},
+			codeReferences: map[string]int{"test-flag": 2},
+			expectedStage:  flags.StageLaunched,
+		},
+		"with a status that's new in every environment": {
+			statuses:       map[string]string{"production": "new", "staging": "new"},
+			codeReferences: map[string]int{},
+			expectedStage:  flags.StageNew,
+		},
+		"with a temporary flag that's inactive with code references": {
+			temporary:      true,
+			statuses:       map[string]string{"production": "inactive"},
+			codeReferences: map[string]int{"test-flag": 2},
+			expectedStage:  flags.StageInactive,
+		},
+		"with a temporary flag that's inactive without code references": {
+			temporary:      true,
+			statuses:       map[string]string{"production": "inactive"},
+			codeReferences: map[string]int{},
+			expectedStage:  flags.StageRemovable,
+		},
+		"with a permanent flag that's inactive without code references": {
+			statuses:       map[string]string{"production": "inactive"},
+			codeReferences: map[string]int{},
+			expectedStage:  flags.StageInactive,
+		},
+		"with a temporary flag that's inactive when code references aren't available": {
+			temporary:     true,
+			statuses:      map[string]string{"production": "inactive"},
+			expectedStage: flags.StageInactive,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			statuses := make(map[string]map[string]flags.FlagStatus)
+			for envKey, status := range tt.statuses {
+				statuses[envKey] = map[string]flags.FlagStatus{"test-flag": {Name: status}}
+			}
+
+			report := flags.NewStaleReport(
+				[]map[string]interface{}{flag("test-flag", tt.temporary)},
+				statuses,
+				tt.codeReferences,
+				time.Now(),
+			)
+
+			require.Len(t, report.Flags, 1)
+			assert.Equal(t, tt.expectedStage, report.Flags[0].Stage)
+		})
+	}
+
+	t.Run("combines the data for each flag", func(t *testing.T) {
+		report := flags.NewStaleReport(
+			[]map[string]interface{}{flag("test-flag", true)},
+			map[string]map[string]flags.FlagStatus{
+				"production": {"test-flag": {Name: "inactive", LastRequested: &earlierRequested}},
+				"staging":    {"test-flag": {Name: "inactive", LastRequested: &lastRequested}},
+			},
+			map[string]int{"test-flag": 3},
+			time.Now(),
+		)
+
+		lastEvaluated := lastRequested.UnixMilli()
+		codeReferences := 3
+		assert.Equal(t, flags.StaleReport{
+			Flags: []flags.FlagReport{{
+				Key:            "test-flag",
+				Name:           "test-flag name",
+				Stage:          flags.StageInactive,
+				Temporary:      true,
+				CreationDate:   created.UnixMilli(),
+				LastEvaluated:  &lastEvaluated,
+				CodeReferences: &codeReferences,
+				Statuses:       map[string]string{"production": "inactive", "staging": "inactive"},
+			}},
+			TotalCount: 1,
+		}, report)
+	})
+
+	t.Run("sorts the stalest flags first", func(t *testing.T) {
+		report := flags.NewStaleReport(
+			[]map[string]interface{}{flag("a-flag", true), flag("b-flag", true), flag("c-flag", true)},
+			map[string]map[string]flags.FlagStatus{
+				"production": {
+					"a-flag": {Name: "active"},
+					"b-flag": {Name: "inactive"},
+					"c-flag": {Name: "launched"},
+				},
+			},
+			map[string]int{"a-flag": 1, "c-flag": 1},
+			time.Now(),
+		)
+
+		keys := make([]string, 0, len(report.Flags))
+		for _, f := range report.Flags {
+			keys = append(keys, f.Key)
+		}
+		assert.Equal(t, []string{"b-flag", "c-flag", "a-flag"}, keys)
+	})
+
+	t.Run("only has flags created before the date", func(t *testing.T) {
+		report := flags.NewStaleReport(
+			[]map[string]interface{}{flag("test-flag", true)},
+			map[string]map[string]flags.FlagStatus{},
+			map[string]int{},
+			created,
+		)
+
+		assert.Empty(t, report.Flags)
+		assert.Equal(t, 1, report.TotalCount)
+	})
+
+	t.Run("warns when code references aren't available", func(t *testing.T) {
+		report := flags.NewStaleReport(nil, nil, nil, time.Now())
+
+		assert.Equal(t, []string{"code references aren't available for the project, so no flags are removable"}, report.Warnings)
+	})
+}
+
+func TestParseAge(t *testing.T) {
+	tests := map[string]struct {
+		value       string
+		expected    time.Duration
+		expectedErr string
+	}{
+		"with days": {
+			value:    "90d",
+			expected: 90 * 24 * time.Hour,
+		},
+		"with weeks": {
+			value:    "2w",
+			expected: 14 * 24 * time.Hour,
+		},
+		"with a duration": {
+			value:    "36h",
+			expected: 36 * time.Hour,
+		},
+		"with an invalid number of days": {
+			value:       "ninetyd",
+			expectedErr: "ninetyd isn't a valid age. Use a number of days, such as 90d",
+		},
+		"with a negative number of days": {
+			value:       "-1d",
+			expectedErr: "-1d isn't a valid age. Use a number of days, such as 90d",
+		},
+		"without a unit": {
+			value:       "90",
+			expectedErr: "90 isn't a valid age. Use a number of days, such as 90d",
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			age, err := flags.ParseAge(tt.value)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, age)
+		})
+	}
+}
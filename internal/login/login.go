@@ -0,0 +1,161 @@
+package login
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"ldcli/internal/errors"
+	"ldcli/internal/resources"
+)
+
+const (
+	ClientID = "ldcli"
+
+	deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+	// defaultInterval is how long to wait between requests for the token when the server doesn't
+	// say, as defined in RFC 8628.
+	defaultInterval = 5 * time.Second
+	// defaultExpiresIn is how long to poll for the token when the server doesn't say how long the
+	// device code lasts.
+	defaultExpiresIn = 15 * time.Minute
+	formContentType  = "application/x-www-form-urlencoded"
+)
+
+// DeviceAuthorization is the response to starting a device authorization flow. The user goes to
+// the verification URI and enters the user code to approve the CLI.
+type DeviceAuthorization struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                *int   `json:"interval"`
+}
+
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// RequestDeviceCode starts the device authorization flow.
+func RequestDeviceCode(client resources.Client, baseURI string) (DeviceAuthorization, error) {
+	res, err := client.MakeRequest(
+		"",
+		"POST",
+		baseURI+"/oauth/device/code",
+		formContentType,
+		nil,
+		[]byte(url.Values{"client_id": {ClientID}}.Encode()),
+	)
+	if err != nil {
+		return DeviceAuthorization{}, errors.NewErrorWrapped(fmt.Sprintf("unable to start login: %s", err.Error()), err)
+	}
+
+	var deviceAuth DeviceAuthorization
+	err = json.Unmarshal(res, &deviceAuth)
+	if err != nil {
+		return DeviceAuthorization{}, errors.NewError("unable to start login: invalid response")
+	}
+
+	return deviceAuth, nil
+}
+
+var errCodeExpired = errors.NewError("login code expired. Run `ldcli login` to try again")
+
+// PollForToken requests the access token until the user approves or denies the CLI or the device
+// code expires. It stops after the device code's lifetime even if the server doesn't respond that
+// it expired.
+func PollForToken(
+	client resources.Client,
+	baseURI string,
+	deviceAuth DeviceAuthorization,
+	sleep func(time.Duration),
+) (string, error) {
+	interval := defaultInterval
+	if deviceAuth.Interval != nil {
+		interval = time.Duration(*deviceAuth.Interval) * time.Second
+	}
+	expiresIn := defaultExpiresIn
+	if deviceAuth.ExpiresIn > 0 {
+		expiresIn = time.Duration(deviceAuth.ExpiresIn) * time.Second
+	}
+	data := url.Values{
+		"client_id":   {ClientID},
+		"device_code": {deviceAuth.DeviceCode},
+		"grant_type":  {deviceCodeGrantType},
+	}
+
+	// the time waiting between requests is counted instead of the time on the clock so tests can
+	// skip waiting
+	var waited time.Duration
+	for {
+		if waited >= expiresIn {
+			return "", errCodeExpired
+		}
+		sleep(interval)
+		waited += interval
+
+		// the token endpoint responds with a 400 error until the user approves the CLI, so the
+		// response body has the status either way
+		res, err := client.MakeRequest(
+			"",
+			"POST",
+			baseURI+"/oauth/token",
+			formContentType,
+			nil,
+			[]byte(data.Encode()),
+		)
+		var token tokenResponse
+		if jsonErr := json.Unmarshal(res, &token); jsonErr != nil {
+			if err != nil {
+				return "", errors.NewErrorWrapped(fmt.Sprintf("unable to log in: %s", err.Error()), err)
+			}
+
+			return "", errors.NewError("unable to log in: invalid response")
+		}
+
+		switch token.Error {
+		case "":
+			if token.AccessToken == "" {
+				return "", errors.NewError("unable to log in: response is missing the access token")
+			}
+
+			return token.AccessToken, nil
+		case "authorization_pending":
+			continue
+		case "slow_down":
+			interval += 5 * time.Second
+		case "access_denied":
+			return "", errors.NewError("login was denied")
+		case "expired_token":
+			return "", errCodeExpired
+		default:
+			message := token.Error
+			if token.ErrorDescription != "" {
+				message = token.ErrorDescription
+			}
+
+			return "", errors.NewError(fmt.Sprintf("unable to log in: %s", message))
+		}
+	}
+}
+
+// RevokeToken revokes the access token so it can't be used again.
+func RevokeToken(client resources.Client, baseURI string, token string) error {
+	_, err := client.MakeRequest(
+		"",
+		"POST",
+		baseURI+"/oauth/revoke",
+		formContentType,
+		nil,
+		[]byte(url.Values{"client_id": {ClientID}, "token": {token}}.Encode()),
+	)
+	if err != nil {
+		return errors.NewErrorWrapped(fmt.Sprintf("unable to revoke access token: %s", err.Error()), err)
+	}
+
+	return nil
+}
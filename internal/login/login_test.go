@@ -0,0 +1,47 @@
+package login_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"ldcli/internal/login"
+	"ldcli/internal/resources"
+)
+
+func TestPollForToken(t *testing.T) {
+	t.Run("stops when the device code expires", func(t *testing.T) {
+		client := &resources.MockClient{
+			Response: []byte(`{"error": "authorization_pending"}`),
+		}
+		interval := 5
+		var waited time.Duration
+
+		_, err := login.PollForToken(
+			client,
+			"http://test.com",
+			login.DeviceAuthorization{DeviceCode: "test-device-code", ExpiresIn: 60, Interval: &interval},
+			func(d time.Duration) { waited += d },
+		)
+
+		assert.EqualError(t, err, "login code expired. Run `ldcli login` to try again")
+		assert.Equal(t, time.Minute, waited)
+	})
+
+	t.Run("gets the access token after it's approved", func(t *testing.T) {
+		client := &resources.MockClient{
+			Response: []byte(`{"access_token": "new-token", "token_type": "bearer"}`),
+		}
+
+		token, err := login.PollForToken(
+			client,
+			"http://test.com",
+			login.DeviceAuthorization{DeviceCode: "test-device-code", ExpiresIn: 60},
+			func(time.Duration) {},
+		)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "new-token", token)
+	})
+}
@@ -0,0 +1,129 @@
+package login
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"ldcli/internal/resources"
+)
+
+// Identity is who the access token belongs to and what it can do.
+type Identity struct {
+	AccountID string  `json:"accountId"`
+	AuthKind  string  `json:"authKind,omitempty"`
+	Member    *Member `json:"member,omitempty"`
+	Token     *Token  `json:"token,omitempty"`
+}
+
+type Member struct {
+	ID        string `json:"_id"`
+	Email     string `json:"email"`
+	FirstName string `json:"firstName,omitempty"`
+	LastName  string `json:"lastName,omitempty"`
+	Role      string `json:"role,omitempty"`
+}
+
+type Token struct {
+	ID     string   `json:"_id,omitempty"`
+	Name   string   `json:"name,omitempty"`
+	Kind   string   `json:"kind,omitempty"`
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+type callerIdentity struct {
+	AccountID string   `json:"accountId"`
+	AuthKind  string   `json:"authKind"`
+	MemberID  string   `json:"memberId"`
+	Scopes    []string `json:"scopes"`
+	TokenID   string   `json:"tokenId"`
+	TokenKind string   `json:"tokenKind"`
+	TokenName string   `json:"tokenName"`
+}
+
+type apiToken struct {
+	CustomRoleIDs []string      `json:"customRoleIds"`
+	InlineRole    []interface{} `json:"inlineRole"`
+	Role          string        `json:"role"`
+}
+
+// WhoAmI gets the member, account, and scopes for the access token.
+func WhoAmI(client resources.Client, accessToken string, baseURI string) ([]byte, error) {
+	res, err := client.MakeRequest(accessToken, "GET", baseURI+"/api/v2/caller-identity", "application/json", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	var caller callerIdentity
+	err = json.Unmarshal(res, &caller)
+	if err != nil {
+		return nil, err
+	}
+
+	identity := Identity{
+		AccountID: caller.AccountID,
+		AuthKind:  caller.AuthKind,
+	}
+	if caller.MemberID != "" {
+		res, err := client.MakeRequest(
+			accessToken,
+			"GET",
+			fmt.Sprintf("%s/api/v2/members/%s", baseURI, caller.MemberID),
+			"application/json",
+			nil,
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+		var member Member
+		err = json.Unmarshal(res, &member)
+		if err != nil {
+			return nil, err
+		}
+		identity.Member = &member
+	}
+	if caller.TokenID != "" || caller.TokenName != "" || len(caller.Scopes) > 0 {
+		identity.Token = &Token{
+			ID:     caller.TokenID,
+			Name:   caller.TokenName,
+			Kind:   caller.TokenKind,
+			Scopes: caller.Scopes,
+		}
+		if len(identity.Token.Scopes) == 0 && caller.TokenID != "" {
+			identity.Token.Scopes = tokenScopes(client, accessToken, baseURI, caller.TokenID)
+		}
+	}
+
+	return json.Marshal(identity)
+}
+
+// tokenScopes gets the roles of an API access token, which are its scopes. Reading tokens needs
+// more access than the token may have, so it doesn't show scopes instead of failing.
+func tokenScopes(client resources.Client, token string, baseURI string, tokenID string) []string {
+	res, err := client.MakeRequest(
+		token,
+		"GET",
+		fmt.Sprintf("%s/api/v2/tokens/%s", baseURI, tokenID),
+		"application/json",
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil
+	}
+	var t apiToken
+	err = json.Unmarshal(res, &t)
+	if err != nil {
+		return nil
+	}
+
+	scopes := make([]string, 0)
+	if t.Role != "" {
+		scopes = append(scopes, t.Role)
+	}
+	scopes = append(scopes, t.CustomRoleIDs...)
+	if len(t.InlineRole) > 0 {
+		scopes = append(scopes, "inline policy")
+	}
+
+	return scopes
+}
@@ -38,9 +38,9 @@ func (c MembersClient) Create(ctx context.Context, accessToken string, baseURI s
 		memberForms = append(memberForms, ldapi.NewMemberForm{Email: m.Email, Role: &m.Role})
 	}
 
-	members, _, err := client.AccountMembersApi.PostMembers(ctx).NewMemberForm(memberForms).Execute()
+	members, res, err := client.AccountMembersApi.PostMembers(ctx).NewMemberForm(memberForms).Execute()
 	if err != nil {
-		return nil, errors.NewLDAPIError(err)
+		return nil, errors.NewLDAPIError(err, res)
 	}
 	membersJson, err := json.Marshal(members)
 	if err != nil {
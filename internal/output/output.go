@@ -1,7 +1,12 @@
 package output
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
 
 	"ldcli/internal/errors"
 )
@@ -18,12 +23,25 @@ var (
 	OutputKindJSON      = OutputKind("json")
 	OutputKindNull      = OutputKind("")
 	OutputKindPlaintext = OutputKind("plaintext")
+	OutputKindTable     = OutputKind("table")
+	OutputKindYAML      = OutputKind("yaml")
 )
 
 func NewOutputKind(s string) (OutputKind, error) {
+	if isTemplateKind(s) {
+		_, err := newTemplate(s)
+		if err != nil {
+			return OutputKindNull, errors.NewError(fmt.Sprintf("output template is invalid: %s", err))
+		}
+
+		return OutputKind(s), nil
+	}
+
 	validKinds := map[string]struct{}{
 		OutputKindJSON.String():      {},
 		OutputKindPlaintext.String(): {},
+		OutputKindTable.String():     {},
+		OutputKindYAML.String():      {},
 	}
 	if _, isValid := validKinds[s]; !isValid {
 		return OutputKindNull, ErrInvalidOutputKind
@@ -90,12 +108,58 @@ func CmdOutputSingular(outputKind string, input []byte, fn PlaintextOutputFn) (s
 }
 
 func outputFromKind(outputKind string, o Outputter) (string, error) {
+	if isTemplateKind(outputKind) {
+		return templateOutput(outputKind, []byte(o.JSON()))
+	}
+
 	switch outputKind {
 	case "json":
 		return o.JSON(), nil
-	case "plaintext":
+	case "plaintext", "table":
 		return o.String(), nil
+	case "yaml":
+		return jsonToYAML([]byte(o.JSON()))
 	}
 
 	return "", ErrInvalidOutputKind
 }
+
+// jsonToYAML converts a JSON response to YAML while keeping the order of its fields.
+func jsonToYAML(input []byte) (string, error) {
+	if len(bytes.TrimSpace(input)) == 0 {
+		return "", nil
+	}
+
+	// remove whitespace since YAML doesn't allow tabs that could be used to indent the JSON
+	var compacted bytes.Buffer
+	err := json.Compact(&compacted, input)
+	if err != nil {
+		return "", err
+	}
+
+	var node yaml.Node
+	err = yaml.Unmarshal(compacted.Bytes(), &node)
+	if err != nil {
+		return "", err
+	}
+	resetYAMLStyle(&node)
+
+	var out bytes.Buffer
+	encoder := yaml.NewEncoder(&out)
+	encoder.SetIndent(2)
+	err = encoder.Encode(&node)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(out.String(), "\n"), nil
+}
+
+// resetYAMLStyle removes the flow style and quotes that come from parsing JSON so the output uses
+// the block style.
+func resetYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, n := range node.Content {
+		resetYAMLStyle(n)
+	}
+}
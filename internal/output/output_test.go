@@ -44,11 +44,81 @@ func TestCmdOutputSingular(t *testing.T) {
 			fn:       output.ErrorPlaintextOutputFn,
 			input:    `{}`,
 		},
+		"with a stale flag report": {
+			expected: "KEY         STAGE       TEMPORARY   CREATED                LAST EVALUATED   CODE REFERENCES\n" +
+				"test-flag   removable   true        2024-01-01T00:00:00Z   never            0",
+			fn: output.FlagsStaleReportPlaintextOutputFn,
+			input: `{"totalCount": 1, "flags": [
+				{"key": "test-flag", "stage": "removable", "temporary": true, "creationDate": 1704067200000, "lastEvaluated": null, "codeReferences": 0}
+			]}`,
+		},
+		"with a stale flag report without flags": {
+			expected: "No flags found\nWarning: code references aren't available for the project, so no flags are removable",
+			fn:       output.FlagsStaleReportPlaintextOutputFn,
+			input:    `{"totalCount": 0, "flags": [], "warnings": ["code references aren't available for the project, so no flags are removable"]}`,
+		},
 		"with a singular resource": {
 			expected: "test-name (test-key)",
 			fn:       output.SingularPlaintextOutputFn,
 			input:    `{"key": "test-key", "name": "test-name"}`,
 		},
+		"with a flag evaluation": {
+			expected: "test-flag: \"blue\" (variation 1)\n" +
+				"Reason: the context matched rule 2, and the context is in an experiment\n" +
+				"Warning: segment test-segment doesn't exist",
+			fn: output.FlagEvaluationPlaintextOutputFn,
+			input: `{
+				"flagKey": "test-flag",
+				"value": "blue",
+				"variationIndex": 1,
+				"reason": {"kind": "RULE_MATCH", "ruleIndex": 1, "ruleId": "rule-id", "inExperiment": true},
+				"warnings": ["segment test-segment doesn't exist"]
+			}`,
+		},
+		"with a flag evaluation without a variation": {
+			expected: "test-flag: null (no variation)\nReason: the flag can't be evaluated (MALFORMED_FLAG)",
+			fn:       output.FlagEvaluationPlaintextOutputFn,
+			input:    `{"flagKey": "test-flag", "value": null, "variationIndex": null, "reason": {"kind": "ERROR", "errorKind": "MALFORMED_FLAG"}}`,
+		},
+		"with applied flag files": {
+			expected: "Created new-flag\n  replace /environments/production/on\ntest-flag is up to date",
+			fn:       output.FlagsApplyPlaintextOutputFn,
+			input: `{"flags": [
+				{"key": "new-flag", "result": "created", "patch": [{"op": "replace", "path": "/environments/production/on", "value": true}]},
+				{"key": "test-flag", "result": "unchanged", "patch": []}
+			]}`,
+		},
+		"with exported flags": {
+			expected: "Exported 1 flag to flags",
+			fn:       output.FlagsExportPlaintextOutputFn,
+			input:    `{"dir": "flags", "flags": ["test-flag"]}`,
+		},
+		"with a flag that's different in two environments": {
+			expected: "test-flag is different in staging and production\n" +
+				"  on: true -> false\n" +
+				"  rules:\n" +
+				"    - {\"variation\":0}\n" +
+				"    + {\"variation\":1}\n" +
+				"  targets: same values in a different order",
+			fn: output.FlagDiffPlaintextOutputFn,
+			input: `{"key": "test-flag", "from": "staging", "to": "production", "differences": [
+				{"setting": "on", "from": true, "to": false},
+				{"setting": "rules", "from": [{"variation": 0}], "to": [{"variation": 1}], "added": [{"variation": 1}], "removed": [{"variation": 0}]},
+				{"setting": "targets", "from": [{"values": ["a"]}, {"values": ["b"]}], "to": [{"values": ["b"]}, {"values": ["a"]}]}
+			]}`,
+		},
+		"with a flag that's the same in two environments": {
+			expected: "test-flag is the same in staging and production",
+			fn:       output.FlagDiffPlaintextOutputFn,
+			input:    `{"key": "test-flag", "from": "staging", "to": "production", "differences": []}`,
+		},
+		"with flags that are different in two environments": {
+			expected: "1 of 3 flags are different in staging and production\n  test-flag: on, rules",
+			fn:       output.FlagsDiffPlaintextOutputFn,
+			input: `{"from": "staging", "to": "production", "totalCount": 3, "flags": [
+				{"key": "test-flag", "differences": [{"setting": "on"}, {"setting": "rules"}]}
+			]}`,
+		},
 	}
 	for name, tt := range tests {
 		tt := tt
@@ -64,3 +134,47 @@ func TestCmdOutputSingular(t *testing.T) {
 		})
 	}
 }
+
+func TestCmdOutputSingularYAML(t *testing.T) {
+	tests := map[string]struct {
+		expected string
+		input    string
+	}{
+		"with scalar values": {
+			expected: "access-token: abcd1234\nanalytics-opt-out: true\nflag-count: 1715212800000",
+			input:    `{"access-token": "abcd1234", "analytics-opt-out": true, "flag-count": 1715212800000}`,
+		},
+		"with nested values": {
+			expected: "environments:\n  production:\n    on: false\ntags:\n  - beta\n  - \"true\"",
+			input:    `{"environments": {"production": {"on": false}}, "tags": ["beta", "true"]}`,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			output, err := output.CmdOutputSingular(
+				"yaml",
+				[]byte(tt.input),
+				output.ConfigPlaintextOutputFn,
+			)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, output)
+		})
+	}
+}
+
+func TestNewOutputKind(t *testing.T) {
+	t.Run("is valid with a template", func(t *testing.T) {
+		kind, err := output.NewOutputKind("template={{.key}}")
+
+		require.NoError(t, err)
+		assert.Equal(t, "template={{.key}}", kind.String())
+	})
+
+	t.Run("is invalid with a template that does not parse", func(t *testing.T) {
+		_, err := output.NewOutputKind("template={{.key")
+
+		assert.ErrorContains(t, err, "output template is invalid")
+	})
+}
@@ -1,16 +1,57 @@
 package output
 
 import (
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strings"
 )
 
 // ConfigPlaintextOutputFn converts the resource to plain text specifically for data from the
-// config file.
+// config file. If there are profiles, it shows the settings for each one and marks the active
+// profile.
 var ConfigPlaintextOutputFn = func(r resource) string {
+	profiles, ok := r["profiles"].(map[string]interface{})
+	if !ok {
+		return configSettings(r)
+	}
+
+	activeProfile, _ := r["active-profile"].(string)
+	profileHeader := func(name string) string {
+		if name == activeProfile {
+			return fmt.Sprintf("[%s] (active)", name)
+		}
+
+		return fmt.Sprintf("[%s]", name)
+	}
+
+	sections := []string{profileHeader("default")}
+	if settings := configSettings(r); settings != "" {
+		sections[0] += "\n" + settings
+	}
+
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		section := profileHeader(name)
+		if profile, ok := profiles[name].(map[string]interface{}); ok && len(profile) > 0 {
+			section += "\n" + configSettings(profile)
+		}
+		sections = append(sections, section)
+	}
+
+	return strings.Join(sections, "\n\n")
+}
+
+func configSettings(r resource) string {
 	keys := make([]string, 0)
 	for k := range r {
+		if k == "profiles" || k == "active-profile" {
+			continue
+		}
 		keys = append(keys, k)
 	}
 	sort.Strings(keys)
@@ -40,6 +81,242 @@ var ErrorPlaintextOutputFn = func(r resource) string {
 	}
 }
 
+// FlagEvaluationPlaintextOutputFn converts the resource to plain text specifically for the
+// result of evaluating a flag for a context.
+var FlagEvaluationPlaintextOutputFn = func(r resource) string {
+	variation := "no variation"
+	if index, ok := r["variationIndex"].(float64); ok {
+		variation = fmt.Sprintf("variation %d", int(index))
+	}
+	lines := []string{fmt.Sprintf("%v: %s (%s)", r["flagKey"], compactJSON(r["value"]), variation)}
+
+	reason, _ := r["reason"].(map[string]interface{})
+	var because string
+	switch reason["kind"] {
+	case "OFF":
+		because = "the flag is off"
+	case "FALLTHROUGH":
+		because = "the context didn't match any targets or rules, so it got the default rule"
+	case "TARGET_MATCH":
+		because = "the context is individually targeted"
+	case "RULE_MATCH":
+		ruleIndex, _ := reason["ruleIndex"].(float64)
+		because = fmt.Sprintf("the context matched rule %d", int(ruleIndex)+1)
+	case "PREREQUISITE_FAILED":
+		because = fmt.Sprintf("prerequisite flag %v is off or doesn't serve the required variation", reason["prerequisiteKey"])
+	default:
+		because = fmt.Sprintf("the flag can't be evaluated (%v)", reason["errorKind"])
+	}
+	if inExperiment, _ := reason["inExperiment"].(bool); inExperiment {
+		because += ", and the context is in an experiment"
+	}
+	lines = append(lines, fmt.Sprintf("Reason: %s", because))
+
+	warnings, _ := r["warnings"].([]interface{})
+	for _, w := range warnings {
+		lines = append(lines, fmt.Sprintf("Warning: %v", w))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// FlagsApplyPlaintextOutputFn converts the resource to plain text specifically for the result of
+// applying flag files. It shows the changes made to each flag.
+var FlagsApplyPlaintextOutputFn = func(r resource) string {
+	flags, _ := r["flags"].([]interface{})
+	lines := make([]string, 0, len(flags))
+	for _, f := range flags {
+		flag, _ := f.(map[string]interface{})
+		switch flag["result"] {
+		case "created":
+			lines = append(lines, fmt.Sprintf("Created %v", flag["key"]))
+		case "updated":
+			lines = append(lines, fmt.Sprintf("Updated %v", flag["key"]))
+		default:
+			lines = append(lines, fmt.Sprintf("%v is up to date", flag["key"]))
+		}
+
+		patch, _ := flag["patch"].([]interface{})
+		for _, p := range patch {
+			op, _ := p.(map[string]interface{})
+			lines = append(lines, fmt.Sprintf("  %v %v", op["op"], op["path"]))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// FlagsClonePlaintextOutputFn converts the resource to plain text specifically for the result of
+// copying a flag to another project.
+var FlagsClonePlaintextOutputFn = func(r resource) string {
+	line := fmt.Sprintf("Cloned %v from %v to %v", r["key"], r["fromProject"], r["toProject"])
+	if r["newKey"] != r["key"] {
+		line += fmt.Sprintf(" as %v", r["newKey"])
+	}
+	lines := []string{line}
+
+	if environments := joinValues(r["environments"]); environments != "" {
+		lines = append(lines, fmt.Sprintf("Copied the targeting in %s", environments))
+	}
+	if skipped := joinValues(r["skippedEnvironments"]); skipped != "" {
+		lines = append(lines, fmt.Sprintf("Didn't copy the targeting in %s because %v doesn't have an environment with the same key", skipped, r["toProject"]))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// FlagDiffPlaintextOutputFn converts the resource to plain text specifically for the differences
+// in a flag's targeting between two environments.
+var FlagDiffPlaintextOutputFn = func(r resource) string {
+	differences, _ := r["differences"].([]interface{})
+	if len(differences) == 0 {
+		return fmt.Sprintf("%v is the same in %v and %v", r["key"], r["from"], r["to"])
+	}
+
+	lines := []string{fmt.Sprintf("%v is different in %v and %v", r["key"], r["from"], r["to"])}
+	for _, d := range differences {
+		diff, _ := d.(map[string]interface{})
+		added, _ := diff["added"].([]interface{})
+		removed, _ := diff["removed"].([]interface{})
+		_, isList := diff["from"].([]interface{})
+		if _, ok := diff["to"].([]interface{}); ok {
+			isList = true
+		}
+		switch {
+		case !isList:
+			lines = append(lines, fmt.Sprintf("  %v: %s -> %s", diff["setting"], compactJSON(diff["from"]), compactJSON(diff["to"])))
+		case len(added) == 0 && len(removed) == 0:
+			lines = append(lines, fmt.Sprintf("  %v: same values in a different order", diff["setting"]))
+		default:
+			lines = append(lines, fmt.Sprintf("  %v:", diff["setting"]))
+			for _, item := range removed {
+				lines = append(lines, fmt.Sprintf("    - %s", compactJSON(item)))
+			}
+			for _, item := range added {
+				lines = append(lines, fmt.Sprintf("    + %s", compactJSON(item)))
+			}
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// FlagsDiffPlaintextOutputFn converts the resource to plain text specifically for a summary of the
+// flags with different targeting in two environments.
+var FlagsDiffPlaintextOutputFn = func(r resource) string {
+	flags, _ := r["flags"].([]interface{})
+	if len(flags) == 0 {
+		return fmt.Sprintf("All %v flags are the same in %v and %v", r["totalCount"], r["from"], r["to"])
+	}
+
+	lines := []string{fmt.Sprintf("%d of %v flags are different in %v and %v", len(flags), r["totalCount"], r["from"], r["to"])}
+	for _, f := range flags {
+		flag, _ := f.(map[string]interface{})
+		differences, _ := flag["differences"].([]interface{})
+		settings := make([]string, 0, len(differences))
+		for _, d := range differences {
+			diff, _ := d.(map[string]interface{})
+			settings = append(settings, fmt.Sprintf("%v", diff["setting"]))
+		}
+		lines = append(lines, fmt.Sprintf("  %v: %s", flag["key"], strings.Join(settings, ", ")))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// FlagsExportPlaintextOutputFn converts the resource to plain text specifically for the result of
+// exporting flags to files.
+var FlagsExportPlaintextOutputFn = func(r resource) string {
+	flags, _ := r["flags"].([]interface{})
+	noun := "flags"
+	if len(flags) == 1 {
+		noun = "flag"
+	}
+
+	return fmt.Sprintf("Exported %d %s to %v", len(flags), noun, r["dir"])
+}
+
+// FlagsPromotePlaintextOutputFn converts the resource to plain text specifically for the changes
+// to promote flags from one environment to another, either before or after they're made.
+var FlagsPromotePlaintextOutputFn = func(r resource) string {
+	flags, _ := r["flags"].([]interface{})
+	if len(flags) == 0 {
+		return fmt.Sprintf("Nothing to promote from %v to %v", r["from"], r["to"])
+	}
+
+	header := fmt.Sprintf("Changes to promote from %v to %v", r["from"], r["to"])
+	if applied, _ := r["applied"].(bool); applied {
+		noun := "flags"
+		if len(flags) == 1 {
+			noun = "flag"
+		}
+		header = fmt.Sprintf("Promoted %d %s from %v to %v", len(flags), noun, r["from"], r["to"])
+	}
+
+	lines := []string{header}
+	for _, f := range flags {
+		flag, _ := f.(map[string]interface{})
+		lines = append(lines, fmt.Sprintf("  %v", flag["key"]))
+		patch, _ := flag["patch"].([]interface{})
+		for _, p := range patch {
+			op, _ := p.(map[string]interface{})
+			lines = append(lines, fmt.Sprintf("    %v %v %s", op["op"], op["path"], compactJSON(op["value"])))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// FlagsStaleReportPlaintextOutputFn converts the resource to plain text specifically for the
+// lifecycle stage of each flag in a project. It shows the flags in a table with the stalest first.
+var FlagsStaleReportPlaintextOutputFn = func(r resource) string {
+	flags, _ := r["flags"].([]interface{})
+	items := make([]resource, 0, len(flags))
+	for _, f := range flags {
+		flag, _ := f.(map[string]interface{})
+		items = append(items, flag)
+	}
+
+	lines := []string{"No flags found"}
+	if len(items) > 0 {
+		lines = []string{renderTable(staleReportColumns, items)}
+	}
+	warnings, _ := r["warnings"].([]interface{})
+	for _, w := range warnings {
+		lines = append(lines, fmt.Sprintf("Warning: %v", w))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+var staleReportColumns = []column{
+	fieldColumn("KEY", "key"),
+	fieldColumn("STAGE", "stage"),
+	fieldColumn("TEMPORARY", "temporary"),
+	{
+		header: "CREATED",
+		valueFn: func(r resource) string {
+			return formatTimestamp(r["creationDate"])
+		},
+	},
+	{
+		header: "LAST EVALUATED",
+		valueFn: func(r resource) string {
+			return formatTimestamp(r["lastEvaluated"])
+		},
+	},
+	{
+		header: "CODE REFERENCES",
+		valueFn: func(r resource) string {
+			if r["codeReferences"] == nil {
+				return "unknown"
+			}
+
+			return formatValue(r["codeReferences"])
+		},
+	},
+}
+
 // MultiplePlaintextOutputFn converts the resource to plain text.
 var MultiplePlaintextOutputFn = func(r resource) string {
 	return fmt.Sprintf("* %s", SingularPlaintextOutputFn(r))
@@ -69,3 +346,65 @@ var SingularPlaintextOutputFn = func(r resource) string {
 		return "cannot read resource"
 	}
 }
+
+// WhoAmIPlaintextOutputFn converts the resource to plain text specifically for data about the
+// caller's identity.
+var WhoAmIPlaintextOutputFn = func(r resource) string {
+	lines := make([]string, 0)
+	if member, ok := r["member"].(map[string]interface{}); ok {
+		name := strings.TrimSpace(fmt.Sprintf("%v %v", valueOrEmpty(member["firstName"]), valueOrEmpty(member["lastName"])))
+		if name == "" {
+			lines = append(lines, fmt.Sprintf("Member: %v", member["email"]))
+		} else {
+			lines = append(lines, fmt.Sprintf("Member: %s (%v)", name, member["email"]))
+		}
+	}
+	lines = append(lines, fmt.Sprintf("Account: %v", r["accountId"]))
+	if token, ok := r["token"].(map[string]interface{}); ok {
+		if token["name"] != nil {
+			line := fmt.Sprintf("Token: %v", token["name"])
+			if token["kind"] != nil {
+				line += fmt.Sprintf(" (%v)", token["kind"])
+			}
+			lines = append(lines, line)
+		}
+		if scopes, ok := token["scopes"].([]interface{}); ok {
+			names := make([]string, 0, len(scopes))
+			for _, s := range scopes {
+				names = append(names, fmt.Sprintf("%v", s))
+			}
+			lines = append(lines, fmt.Sprintf("Scopes: %s", strings.Join(names, ", ")))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func valueOrEmpty(v interface{}) interface{} {
+	if v == nil {
+		return ""
+	}
+
+	return v
+}
+
+// joinValues formats a list of values as a comma-separated string.
+func joinValues(v interface{}) string {
+	values, _ := v.([]interface{})
+	strs := make([]string, 0, len(values))
+	for _, value := range values {
+		strs = append(strs, fmt.Sprintf("%v", value))
+	}
+
+	return strings.Join(strs, ", ")
+}
+
+// compactJSON formats the value as JSON on one line. A missing value is shown as null.
+func compactJSON(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return string(data)
+}
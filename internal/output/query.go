@@ -0,0 +1,130 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/jmespath/go-jmespath"
+
+	"ldcli/internal/errors"
+)
+
+const templateKindPrefix = "template="
+
+// searchQuery gets the value at the JMESPath query in the data, such as
+// "environments.production.on" or "items[*].key". Missing values are nil.
+func searchQuery(query string, data interface{}) (interface{}, error) {
+	jp, err := jmespath.Compile(query)
+	if err != nil {
+		if syntaxErr, ok := err.(jmespath.SyntaxError); ok {
+			return nil, errors.NewError(fmt.Sprintf(
+				"query is invalid: %s\n%s",
+				strings.TrimPrefix(syntaxErr.Error(), "SyntaxError: "),
+				syntaxErr.HighlightLocation(),
+			))
+		}
+
+		return nil, errors.NewError(fmt.Sprintf("query is invalid: %s", err))
+	}
+
+	result, err := jp.Search(data)
+	if err != nil {
+		return nil, errors.NewError(fmt.Sprintf("query %s can't be applied: %s", query, err))
+	}
+
+	return result, nil
+}
+
+// queryOutput extracts the value at the query from the response. Scalar values are returned as is
+// so they can be used in scripts, and other values are formatted based on the output kind.
+func queryOutput(action string, outputKind string, input []byte, opts CmdOutputOpts) (string, error) {
+	var data interface{}
+	err := json.Unmarshal(input, &data)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := searchQuery(opts.Query, data)
+	if err != nil {
+		return "", err
+	}
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", err
+	}
+
+	if isTemplateKind(outputKind) {
+		return templateOutput(outputKind, resultJSON)
+	}
+
+	switch r := result.(type) {
+	case nil:
+		return "null", nil
+	case []interface{}:
+		if outputKind == OutputKindTable.String() {
+			// show each element as a row
+			resultJSON, err = json.Marshal(map[string]interface{}{"items": r})
+			if err != nil {
+				return "", err
+			}
+		}
+	case map[string]interface{}:
+	default:
+		return formatValue(result), nil
+	}
+
+	switch outputKind {
+	case OutputKindJSON.String(), OutputKindYAML.String(), OutputKindTable.String():
+		return CmdOutput(action, outputKind, resultJSON, CmdOutputOpts{Columns: opts.Columns})
+	default:
+		indented, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return "", err
+		}
+
+		return string(indented), nil
+	}
+}
+
+func isTemplateKind(outputKind string) bool {
+	return strings.HasPrefix(outputKind, templateKindPrefix)
+}
+
+func newTemplate(outputKind string) (*template.Template, error) {
+	return template.New("output").
+		Funcs(template.FuncMap{
+			"json": func(v interface{}) (string, error) {
+				out, err := json.Marshal(v)
+
+				return string(out), err
+			},
+		}).
+		Parse(strings.TrimPrefix(outputKind, templateKindPrefix))
+}
+
+// templateOutput executes the Go template from the output kind with the response as its data.
+func templateOutput(outputKind string, input []byte) (string, error) {
+	tmpl, err := newTemplate(outputKind)
+	if err != nil {
+		return "", err
+	}
+
+	var data interface{}
+	if len(bytes.TrimSpace(input)) > 0 {
+		err = json.Unmarshal(input, &data)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	var out bytes.Buffer
+	err = tmpl.Execute(&out, data)
+	if err != nil {
+		return "", errors.NewError(err.Error())
+	}
+
+	return out.String(), nil
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
@@ -13,11 +14,29 @@ import (
 	errs "ldcli/internal/errors"
 )
 
+// CmdOutputOpts are settings from the user that change how a response is displayed.
+type CmdOutputOpts struct {
+	// Columns are the fields to show when the output kind is a table.
+	Columns []string
+	// Query is a path to a value in the response to show instead of the whole response.
+	Query string
+}
+
 // CmdOutput returns a response from a resource action formatted based on the output flag along with
 // an optional message based on the action.
-func CmdOutput(action string, outputKind string, input []byte) (string, error) {
-	if outputKind == "json" {
+func CmdOutput(action string, outputKind string, input []byte, opts CmdOutputOpts) (string, error) {
+	if opts.Query != "" {
+		return queryOutput(action, outputKind, input, opts)
+	}
+	if isTemplateKind(outputKind) {
+		return templateOutput(outputKind, input)
+	}
+
+	switch outputKind {
+	case "json":
 		return string(input), nil
+	case "yaml":
+		return jsonToYAML(input)
 	}
 
 	var (
@@ -47,6 +66,14 @@ func CmdOutput(action string, outputKind string, input []byte) (string, error) {
 		}
 	}
 
+	if outputKind == "table" {
+		if !isMultipleResponse {
+			return tableOutput([]resource{maybeResource}, opts.Columns), nil
+		}
+
+		return tableOutput(maybeResources.Items, opts.Columns), nil
+	}
+
 	var successMessage string
 	switch action {
 	case "create":
@@ -111,37 +138,77 @@ func plaintextOutput(out string, successMessage string) string {
 	return out
 }
 
-// CmdOutputError returns a response from a resource action error.
-func CmdOutputError(outputKind string, err error) string {
-	var output string
+// ErrorEnvelope is the shape of every error in JSON and YAML output so scripts can handle errors
+// the same way regardless of where they come from.
+type ErrorEnvelope struct {
+	Code       string `json:"code"`
+	Message    string `json:"message"`
+	StatusCode int    `json:"statusCode,omitempty"`
+	RequestID  string `json:"requestId,omitempty"`
+	DocsURL    string `json:"docsUrl,omitempty"`
+}
+
+// NewErrorEnvelope gets the details of the error. API errors have a JSON body with a code and
+// message, and other errors only have a message.
+func NewErrorEnvelope(err error) ErrorEnvelope {
+	var envelope ErrorEnvelope
 	jsonErr := &json.UnmarshalTypeError{}
+	var responseErr errs.ResponseError
 	switch {
 	case errors.As(err, &jsonErr):
-		output = errJSON("invalid JSON")
-	case errors.As(err, &errs.Error{}):
-		output = err.Error()
+		envelope.Message = "invalid JSON"
+	case errors.As(err, &responseErr):
+		envelope.StatusCode = responseErr.StatusCode
+		envelope.RequestID = responseErr.RequestID
+		if json.Unmarshal(responseErr.Body, &envelope) != nil || envelope.Message == "" {
+			envelope.Message = strings.TrimSpace(string(responseErr.Body))
+		}
+		if envelope.Message == "" {
+			envelope.Message = strings.ToLower(http.StatusText(responseErr.StatusCode))
+		}
 	default:
-		output = errJSON(err.Error())
+		// the error may already be formatted as JSON
+		if json.Unmarshal([]byte(err.Error()), &envelope) != nil || envelope.Message == "" {
+			envelope = ErrorEnvelope{Message: err.Error()}
+		}
+	}
+
+	if envelope.Code == "" {
+		envelope.Code = errs.Code(err)
+	}
+	if envelope.DocsURL == "" {
+		switch envelope.StatusCode {
+		case http.StatusUnauthorized, http.StatusForbidden:
+			envelope.DocsURL = "https://apidocs.launchdarkly.com/#section/Overview/Authentication"
+		case http.StatusTooManyRequests:
+			envelope.DocsURL = "https://apidocs.launchdarkly.com/#section/Overview/Rate-limiting"
+		}
 	}
 
-	var r resource
-	_ = json.Unmarshal([]byte(output), &r)
+	return envelope
+}
 
-	if outputKind == "json" {
-		// convert to a well-formatted output
-		formattedOutput, _ := json.Marshal(r)
+// CmdOutputError returns a response from a resource action error.
+func CmdOutputError(outputKind string, err error) string {
+	envelope := NewErrorEnvelope(err)
+
+	switch outputKind {
+	case "json":
+		formattedOutput, _ := json.Marshal(envelope)
 
 		return string(formattedOutput)
+	case "yaml":
+		formattedOutput, _ := json.Marshal(envelope)
+		yamlOutput, _ := jsonToYAML(formattedOutput)
+
+		return yamlOutput
 	}
 
-	return ErrorPlaintextOutputFn(r)
-}
+	// only show a code that came with the error since the others don't add to the message
+	r := resource{"message": envelope.Message}
+	if envelope.StatusCode != 0 || envelope.Code != errs.Code(err) {
+		r["code"] = envelope.Code
+	}
 
-func errJSON(s string) string {
-	return fmt.Sprintf(
-		`{
-			"message": %q
-		}`,
-		s,
-	)
+	return ErrorPlaintextOutputFn(r)
 }
@@ -26,7 +26,7 @@ func TestCmdOutput(t *testing.T) {
 			t.Run("returns a success message", func(t *testing.T) {
 				expected := "* test-id"
 
-				result, err := output.CmdOutput("list", "plaintext", []byte(input))
+				result, err := output.CmdOutput("list", "plaintext", []byte(input), output.CmdOutputOpts{})
 
 				require.NoError(t, err)
 				assert.Equal(t, expected, result)
@@ -78,7 +78,7 @@ func TestCmdOutput(t *testing.T) {
 					tt.offset,
 				)
 
-				result, err := output.CmdOutput("list", "plaintext", []byte(input))
+				result, err := output.CmdOutput("list", "plaintext", []byte(input), output.CmdOutputOpts{})
 
 				require.NoError(t, err)
 				assert.Equal(t, tt.expected, result)
@@ -100,7 +100,7 @@ func TestCmdOutput(t *testing.T) {
 			t.Run("returns a list of resources", func(t *testing.T) {
 				expected := "* test-name (test-id)"
 
-				result, err := output.CmdOutput("list", "plaintext", []byte(input))
+				result, err := output.CmdOutput("list", "plaintext", []byte(input), output.CmdOutputOpts{})
 
 				require.NoError(t, err)
 				assert.Equal(t, expected, result)
@@ -127,7 +127,7 @@ func TestCmdOutput(t *testing.T) {
 			t.Run("returns the list", func(t *testing.T) {
 				expected := "* tag1\n* tag2\nShowing results 1 - 2 of 2."
 
-				result, err := output.CmdOutput("list", "plaintext", []byte(input))
+				result, err := output.CmdOutput("list", "plaintext", []byte(input), output.CmdOutputOpts{})
 
 				require.NoError(t, err)
 				assert.Equal(t, expected, result)
@@ -144,7 +144,7 @@ func TestCmdOutput(t *testing.T) {
 
 		t.Run("with JSON output", func(t *testing.T) {
 			t.Run("returns the JSON", func(t *testing.T) {
-				result, err := output.CmdOutput("create", "json", []byte(input))
+				result, err := output.CmdOutput("create", "json", []byte(input), output.CmdOutputOpts{})
 
 				require.NoError(t, err)
 				assert.JSONEq(t, input, result)
@@ -155,7 +155,18 @@ func TestCmdOutput(t *testing.T) {
 			t.Run("returns a success message", func(t *testing.T) {
 				expected := "Successfully created test-name (test-key)"
 
-				result, err := output.CmdOutput("create", "plaintext", []byte(input))
+				result, err := output.CmdOutput("create", "plaintext", []byte(input), output.CmdOutputOpts{})
+
+				require.NoError(t, err)
+				assert.Equal(t, expected, result)
+			})
+		})
+
+		t.Run("with YAML output", func(t *testing.T) {
+			t.Run("returns the YAML", func(t *testing.T) {
+				expected := "key: test-key\nname: test-name\nother: other-value"
+
+				result, err := output.CmdOutput("create", "yaml", []byte(input), output.CmdOutputOpts{})
 
 				require.NoError(t, err)
 				assert.Equal(t, expected, result)
@@ -176,7 +187,7 @@ func TestCmdOutput(t *testing.T) {
 
 		t.Run("with JSON output", func(t *testing.T) {
 			t.Run("returns the JSON", func(t *testing.T) {
-				result, err := output.CmdOutput("create", "json", []byte(input))
+				result, err := output.CmdOutput("create", "json", []byte(input), output.CmdOutputOpts{})
 
 				require.NoError(t, err)
 				assert.JSONEq(t, input, result)
@@ -187,7 +198,7 @@ func TestCmdOutput(t *testing.T) {
 			t.Run("returns a success message", func(t *testing.T) {
 				expected := "Successfully created\n* test-name (test-key)"
 
-				result, err := output.CmdOutput("create", "plaintext", []byte(input))
+				result, err := output.CmdOutput("create", "plaintext", []byte(input), output.CmdOutputOpts{})
 
 				require.NoError(t, err)
 				assert.Equal(t, expected, result)
@@ -208,7 +219,7 @@ func TestCmdOutput(t *testing.T) {
 
 		t.Run("with JSON output", func(t *testing.T) {
 			t.Run("returns the JSON", func(t *testing.T) {
-				result, err := output.CmdOutput("create", "json", []byte(input))
+				result, err := output.CmdOutput("create", "json", []byte(input), output.CmdOutputOpts{})
 
 				require.NoError(t, err)
 				assert.JSONEq(t, input, result)
@@ -219,7 +230,7 @@ func TestCmdOutput(t *testing.T) {
 			t.Run("returns a success message", func(t *testing.T) {
 				expected := "Successfully created\n* test-email (test-id)"
 
-				result, err := output.CmdOutput("create", "plaintext", []byte(input))
+				result, err := output.CmdOutput("create", "plaintext", []byte(input), output.CmdOutputOpts{})
 
 				require.NoError(t, err)
 				assert.Equal(t, expected, result)
@@ -235,7 +246,7 @@ func TestCmdOutput(t *testing.T) {
 
 		t.Run("with JSON output", func(t *testing.T) {
 			t.Run("does not return anything", func(t *testing.T) {
-				result, err := output.CmdOutput("delete", "json", []byte(""))
+				result, err := output.CmdOutput("delete", "json", []byte(""), output.CmdOutputOpts{})
 
 				require.NoError(t, err)
 				assert.Equal(t, "", result)
@@ -247,7 +258,7 @@ func TestCmdOutput(t *testing.T) {
 				t.Run("returns a success message", func(t *testing.T) {
 					expected := "Successfully deleted test-name (test-key)"
 
-					result, err := output.CmdOutput("delete", "plaintext", []byte(input))
+					result, err := output.CmdOutput("delete", "plaintext", []byte(input), output.CmdOutputOpts{})
 
 					require.NoError(t, err)
 					assert.Equal(t, expected, result)
@@ -261,7 +272,7 @@ func TestCmdOutput(t *testing.T) {
 						"key": "test-key"
 					}`
 
-					result, err := output.CmdOutput("delete", "plaintext", []byte(input))
+					result, err := output.CmdOutput("delete", "plaintext", []byte(input), output.CmdOutputOpts{})
 
 					require.NoError(t, err)
 					assert.Equal(t, expected, result)
@@ -279,7 +290,7 @@ func TestCmdOutput(t *testing.T) {
 
 		t.Run("with JSON output", func(t *testing.T) {
 			t.Run("returns the JSON", func(t *testing.T) {
-				result, err := output.CmdOutput("update", "json", []byte(input))
+				result, err := output.CmdOutput("update", "json", []byte(input), output.CmdOutputOpts{})
 
 				require.NoError(t, err)
 				assert.JSONEq(t, input, result)
@@ -290,7 +301,7 @@ func TestCmdOutput(t *testing.T) {
 			t.Run("returns a success message", func(t *testing.T) {
 				expected := "Successfully updated test-name (test-key)"
 
-				result, err := output.CmdOutput("update", "plaintext", []byte(input))
+				result, err := output.CmdOutput("update", "plaintext", []byte(input), output.CmdOutputOpts{})
 
 				require.NoError(t, err)
 				assert.Equal(t, expected, result)
@@ -299,6 +310,210 @@ func TestCmdOutput(t *testing.T) {
 	})
 }
 
+func TestCmdOutputTable(t *testing.T) {
+	t.Run("with flags shows their on state in each environment", func(t *testing.T) {
+		input := `{
+			"items": [
+				{
+					"key": "flag-1",
+					"name": "Flag 1",
+					"kind": "boolean",
+					"environments": {
+						"production": {"on": false},
+						"test": {"on": true}
+					}
+				},
+				{
+					"key": "flag-2",
+					"name": "Flag 2",
+					"kind": "multivariate",
+					"environments": {
+						"production": {"on": true},
+						"test": {"on": true}
+					}
+				}
+			]
+		}`
+		expected := "" +
+			"KEY      NAME     KIND           PRODUCTION   TEST\n" +
+			"flag-1   Flag 1   boolean        off          on\n" +
+			"flag-2   Flag 2   multivariate   on           on"
+
+		result, err := output.CmdOutput("list", "table", []byte(input), output.CmdOutputOpts{})
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("with members shows when they were last seen", func(t *testing.T) {
+		input := `{
+			"items": [
+				{
+					"_id": "test-id",
+					"email": "test@test.com",
+					"role": "admin",
+					"_lastSeen": 1715212800000
+				},
+				{
+					"_id": "test-id-2",
+					"email": "test2@test.com",
+					"role": "reader"
+				}
+			]
+		}`
+		expected := "" +
+			"EMAIL            ROLE     LAST SEEN\n" +
+			"test@test.com    admin    2024-05-09T00:00:00Z\n" +
+			"test2@test.com   reader   never"
+
+		result, err := output.CmdOutput("list", "table", []byte(input), output.CmdOutputOpts{})
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("with columns shows only those fields", func(t *testing.T) {
+		input := `{
+			"key": "flag-1",
+			"name": "Flag 1",
+			"kind": "boolean",
+			"tags": ["a", "b"],
+			"environments": {
+				"production": {"on": true}
+			}
+		}`
+		expected := "" +
+			"KEY      TAGS        ENVIRONMENTS.PRODUCTION.ON\n" +
+			"flag-1   [\"a\",\"b\"]   true"
+
+		result, err := output.CmdOutput("get", "table", []byte(input), output.CmdOutputOpts{
+			Columns: []string{"key", "tags", "environments.production.on"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("without any items", func(t *testing.T) {
+		result, err := output.CmdOutput("list", "table", []byte(`{"items": []}`), output.CmdOutputOpts{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "No items found", result)
+	})
+}
+
+func TestCmdOutputQuery(t *testing.T) {
+	input := `{
+		"key": "test-flag",
+		"environments": {
+			"production": {"on": true, "version": 2},
+			"test": {"on": false, "version": 7}
+		},
+		"tags": ["beta", "test"],
+		"variations": [
+			{"_id": "id-1", "value": true},
+			{"_id": "id-2", "value": false}
+		]
+	}`
+	tests := map[string]struct {
+		expected   string
+		outputKind string
+		query      string
+	}{
+		"with a nested boolean": {
+			expected:   "true",
+			outputKind: "plaintext",
+			query:      "environments.production.on",
+		},
+		"with a number": {
+			expected:   "7",
+			outputKind: "json",
+			query:      "environments.test.version",
+		},
+		"with an index": {
+			expected:   "id-2",
+			outputKind: "plaintext",
+			query:      "variations[1]._id",
+		},
+		"with a wildcard over a list": {
+			expected:   `["id-1","id-2"]`,
+			outputKind: "json",
+			query:      "variations[*]._id",
+		},
+		"with a wildcard over an object and a function": {
+			expected:   "- 2\n- 7",
+			outputKind: "yaml",
+			query:      "sort(environments.*.version)",
+		},
+		"with a filter": {
+			expected:   `["id-1"]`,
+			outputKind: "json",
+			query:      "variations[?value]._id",
+		},
+		"with a quoted key": {
+			expected:   "2",
+			outputKind: "plaintext",
+			query:      `environments."production".version`,
+		},
+		"with a list as plaintext": {
+			expected:   "[\n  \"beta\",\n  \"test\"\n]",
+			outputKind: "plaintext",
+			query:      "tags",
+		},
+		"with a missing value": {
+			expected:   "null",
+			outputKind: "plaintext",
+			query:      "environments.staging.on",
+		},
+		"with a template": {
+			expected:   "production is on",
+			outputKind: `template=production is {{if .}}on{{else}}off{{end}}`,
+			query:      "environments.production.on",
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			result, err := output.CmdOutput("get", tt.outputKind, []byte(input), output.CmdOutputOpts{
+				Query: tt.query,
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+
+	t.Run("is an error with an invalid query", func(t *testing.T) {
+		_, err := output.CmdOutput("get", "plaintext", []byte(input), output.CmdOutputOpts{
+			Query: "variations[0",
+		})
+
+		assert.EqualError(t, err, "query is invalid: Expected tRbracket, received: tEOF\nvariations[0\n            ^")
+	})
+}
+
+func TestCmdOutputTemplate(t *testing.T) {
+	t.Run("with a list of resources", func(t *testing.T) {
+		input := `{
+			"items": [
+				{"key": "flag-1", "tags": ["a"]},
+				{"key": "flag-2", "tags": []}
+			]
+		}`
+		expected := "flag-1 [\"a\"]\nflag-2 []\n"
+
+		result, err := output.CmdOutput(
+			"list",
+			`template={{range .items}}{{.key}} {{json .tags}}{{"\n"}}{{end}}`,
+			[]byte(input),
+			output.CmdOutputOpts{},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+}
+
 func TestCmdOutputError(t *testing.T) {
 	t.Run("with an API error", func(t *testing.T) {
 		t.Run("with JSON output", func(t *testing.T) {
@@ -310,6 +525,15 @@ func TestCmdOutputError(t *testing.T) {
 			assert.JSONEq(t, expected, result)
 		})
 
+		t.Run("with YAML output", func(t *testing.T) {
+			expected := "code: conflict\nmessage: an error"
+			err := errors.NewError(`{"code":"conflict", "message":"an error"}`)
+
+			result := output.CmdOutputError("yaml", err)
+
+			assert.Equal(t, expected, result)
+		})
+
 		t.Run("with plaintext output", func(t *testing.T) {
 			expected := "invalid JSON"
 			type testType any
@@ -322,4 +546,56 @@ func TestCmdOutputError(t *testing.T) {
 			assert.Equal(t, expected, result)
 		})
 	})
+
+	t.Run("with an API response error", func(t *testing.T) {
+		t.Run("with JSON output", func(t *testing.T) {
+			expected := `{
+				"code": "unauthorized",
+				"message": "Invalid access token",
+				"statusCode": 401,
+				"requestId": "test-request-id",
+				"docsUrl": "https://apidocs.launchdarkly.com/#section/Overview/Authentication"
+			}`
+			err := errors.NewResponseError(401, "test-request-id", []byte(`{"code":"unauthorized","message":"Invalid access token"}`))
+
+			result := output.CmdOutputError("json", err)
+
+			assert.JSONEq(t, expected, result)
+		})
+
+		t.Run("without a response body", func(t *testing.T) {
+			expected := `{"code": "server_error", "message": "service unavailable", "statusCode": 503}`
+			err := errors.NewResponseError(503, "", nil)
+
+			result := output.CmdOutputError("json", err)
+
+			assert.JSONEq(t, expected, result)
+		})
+
+		t.Run("with plaintext output", func(t *testing.T) {
+			err := errors.NewResponseError(404, "", []byte(`{"code":"not_found","message":"Unknown project"}`))
+
+			result := output.CmdOutputError("plaintext", err)
+
+			assert.Equal(t, "Unknown project (code: not_found)", result)
+		})
+	})
+
+	t.Run("with another error", func(t *testing.T) {
+		t.Run("with JSON output", func(t *testing.T) {
+			err := errors.NewError("an error")
+
+			result := output.CmdOutputError("json", err)
+
+			assert.JSONEq(t, `{"code": "error", "message": "an error"}`, result)
+		})
+
+		t.Run("with plaintext output", func(t *testing.T) {
+			err := errors.NewError("an error")
+
+			result := output.CmdOutputError("plaintext", err)
+
+			assert.Equal(t, "an error", result)
+		})
+	})
 }
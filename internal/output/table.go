@@ -0,0 +1,230 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// column is a single column of table output with a function to get its value from a resource.
+type column struct {
+	header  string
+	valueFn func(resource) string
+}
+
+// tableOutput renders the resources as aligned columns. If no columns are given, it picks default
+// columns based on the shape of the resources.
+func tableOutput(items []resource, fields []string) string {
+	if len(items) == 0 {
+		return "No items found"
+	}
+
+	columns := fieldColumns(fields)
+	if len(columns) == 0 {
+		columns = defaultColumns(items)
+	}
+
+	return renderTable(columns, items)
+}
+
+// renderTable renders a row for each resource with a cell for each column.
+func renderTable(columns []column, items []resource) string {
+	headers := make([]string, 0, len(columns))
+	for _, c := range columns {
+		headers = append(headers, c.header)
+	}
+	rows := make([][]string, 0, len(items))
+	for _, i := range items {
+		row := make([]string, 0, len(columns))
+		for _, c := range columns {
+			row = append(row, c.valueFn(i))
+		}
+		rows = append(rows, row)
+	}
+
+	var sb strings.Builder
+	table := tablewriter.NewWriter(&sb)
+	table.SetHeader(headers)
+	table.SetAutoFormatHeaders(false)
+	table.SetAutoWrapText(false)
+	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetCenterSeparator("")
+	table.SetColumnSeparator("")
+	table.SetRowSeparator("")
+	table.SetHeaderLine(false)
+	table.SetBorder(false)
+	table.SetTablePadding("   ")
+	table.SetNoWhiteSpace(true)
+	table.AppendBulk(rows)
+	table.Render()
+
+	// the table pads every cell, including the last one in each row
+	lines := strings.Split(strings.TrimRight(sb.String(), "\n"), "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimRight(l, " ")
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// fieldColumns builds columns from user-provided fields. A field can be a path to a nested value
+// separated by dots, such as "environments.production.on".
+func fieldColumns(fields []string) []column {
+	columns := make([]column, 0, len(fields))
+	for _, f := range fields {
+		f := strings.TrimSpace(f)
+		if f == "" {
+			continue
+		}
+		columns = append(columns, fieldColumn(strings.ToUpper(f), f))
+	}
+
+	return columns
+}
+
+func fieldColumn(header string, field string) column {
+	return column{
+		header: header,
+		valueFn: func(r resource) string {
+			return formatValue(lookupPath(r, field))
+		},
+	}
+}
+
+// defaultColumns picks the columns for a resource. Flags and members have their own columns, and
+// every other resource shows its identifying fields.
+func defaultColumns(items []resource) []column {
+	switch {
+	case isFlag(items[0]):
+		return flagColumns(items)
+	case isMember(items[0]):
+		return []column{
+			fieldColumn("EMAIL", "email"),
+			fieldColumn("ROLE", "role"),
+			{
+				header: "LAST SEEN",
+				valueFn: func(r resource) string {
+					return formatTimestamp(r["_lastSeen"])
+				},
+			},
+		}
+	}
+
+	fields := make([]string, 0)
+	for _, f := range []string{"key", "name", "email", "_id"} {
+		for _, i := range items {
+			if _, ok := i[f]; ok {
+				fields = append(fields, f)
+				break
+			}
+		}
+	}
+
+	return fieldColumns(fields)
+}
+
+func isFlag(r resource) bool {
+	_, hasEnvironments := r["environments"]
+	_, hasKind := r["kind"]
+
+	return hasEnvironments && hasKind
+}
+
+func isMember(r resource) bool {
+	_, hasEmail := r["email"]
+	_, hasRole := r["role"]
+
+	return hasEmail && hasRole
+}
+
+// flagColumns shows each flag's key, name, and kind, along with whether it's on or off in every
+// environment.
+func flagColumns(items []resource) []column {
+	columns := []column{
+		fieldColumn("KEY", "key"),
+		fieldColumn("NAME", "name"),
+		fieldColumn("KIND", "kind"),
+	}
+
+	envKeys := make(map[string]struct{})
+	for _, i := range items {
+		if envs, ok := i["environments"].(map[string]interface{}); ok {
+			for k := range envs {
+				envKeys[k] = struct{}{}
+			}
+		}
+	}
+	sortedEnvKeys := make([]string, 0, len(envKeys))
+	for k := range envKeys {
+		sortedEnvKeys = append(sortedEnvKeys, k)
+	}
+	sort.Strings(sortedEnvKeys)
+
+	for _, k := range sortedEnvKeys {
+		k := k
+		columns = append(columns, column{
+			header: strings.ToUpper(k),
+			valueFn: func(r resource) string {
+				// environment keys can have characters that would need to be quoted in a query
+				environments, _ := r["environments"].(map[string]interface{})
+				env, _ := environments[k].(map[string]interface{})
+				switch env["on"] {
+				case true:
+					return "on"
+				case false:
+					return "off"
+				default:
+					return ""
+				}
+			},
+		})
+	}
+
+	return columns
+}
+
+// lookupPath gets a nested value from the resource using the same syntax as a query.
+func lookupPath(r resource, path string) interface{} {
+	value, err := searchQuery(path, map[string]interface{}(r))
+	if err != nil {
+		return nil
+	}
+
+	return value
+}
+
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		out, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+
+		return string(out)
+	}
+}
+
+// formatTimestamp converts a Unix timestamp in milliseconds to a date.
+func formatTimestamp(value interface{}) string {
+	ms, ok := value.(float64)
+	if !ok || ms == 0 {
+		return "never"
+	}
+
+	return time.UnixMilli(int64(ms)).UTC().Format(time.RFC3339)
+}
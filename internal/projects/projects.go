@@ -36,9 +36,9 @@ func (c ProjectsClient) Create(
 ) ([]byte, error) {
 	client := client.New(accessToken, baseURI, c.cliVersion)
 	projectPost := ldapi.NewProjectPost(name, key)
-	project, _, err := client.ProjectsApi.PostProject(ctx).ProjectPost(*projectPost).Execute()
+	project, res, err := client.ProjectsApi.PostProject(ctx).ProjectPost(*projectPost).Execute()
 	if err != nil {
-		return nil, errors.NewLDAPIError(err)
+		return nil, errors.NewLDAPIError(err, res)
 	}
 	projectJSON, err := json.Marshal(project)
 	if err != nil {
@@ -54,10 +54,10 @@ func (c ProjectsClient) List(
 	baseURI string,
 ) ([]byte, error) {
 	client := client.New(accessToken, baseURI, c.cliVersion)
-	projects, _, err := client.ProjectsApi.
+	projects, res, err := client.ProjectsApi.
 		GetProjects(ctx).Execute()
 	if err != nil {
-		return nil, errors.NewLDAPIError(err)
+		return nil, errors.NewLDAPIError(err, res)
 	}
 
 	projectsJSON, err := json.Marshal(projects)
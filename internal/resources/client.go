@@ -4,49 +4,172 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"math/rand"
 	"net/http"
 	"net/url"
+	"strconv"
+	"time"
 
+	"ldcli/internal/debug"
 	"ldcli/internal/errors"
 )
 
+const (
+	DefaultMaxRetries = 3
+	DefaultTimeout    = time.Minute
+
+	retryBaseDelay = 500 * time.Millisecond
+	retryMaxDelay  = 30 * time.Second
+)
+
 type Client interface {
 	MakeRequest(accessToken, method, path, contentType string, query url.Values, data []byte) ([]byte, error)
 }
 
+// RequestOptions configures how a client sends requests.
+type RequestOptions struct {
+	// MaxRetries is how many times to retry a request that was rate limited or failed because of
+	// a server error.
+	MaxRetries int
+	// Timeout is how long to wait for each attempt of a request. Zero means no timeout.
+	Timeout time.Duration
+}
+
 type ResourcesClient struct {
 	cliVersion string
+	options    RequestOptions
 }
 
 var _ Client = ResourcesClient{}
 
 func NewClient(cliVersion string) ResourcesClient {
-	return ResourcesClient{cliVersion: cliVersion}
+	return ResourcesClient{
+		cliVersion: cliVersion,
+		options: RequestOptions{
+			MaxRetries: DefaultMaxRetries,
+			Timeout:    DefaultTimeout,
+		},
+	}
+}
+
+// WithOptions returns a copy of the client that uses the options. The options come from flags, so
+// they're set when a command runs instead of when the client is created.
+func (c ResourcesClient) WithOptions(options RequestOptions) ResourcesClient {
+	c.options = options
+
+	return c
 }
 
 func (c ResourcesClient) MakeRequest(accessToken, method, path, contentType string, query url.Values, data []byte) ([]byte, error) {
-	client := http.Client{}
+	client := http.Client{
+		Timeout:   c.options.Timeout,
+		Transport: debug.NewTransport(http.DefaultTransport),
+	}
 
-	req, _ := http.NewRequest(method, path, bytes.NewReader(data))
-	req.Header.Add("Authorization", accessToken)
-	req.Header.Add("Content-type", contentType)
-	req.Header.Set("User-Agent", fmt.Sprintf("launchdarkly-cli/v%s", c.cliVersion))
-	req.URL.RawQuery = query.Encode()
+	for attempt := 0; ; attempt++ {
+		req := newRequest(c.cliVersion, accessToken, method, path, contentType, query, data)
+		res, err := client.Do(req)
+		if err != nil {
+			if attempt < c.options.MaxRetries && isIdempotent(method) {
+				time.Sleep(backoffDelay(attempt))
+				continue
+			}
+
+			return nil, err
+		}
+
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		if attempt < c.options.MaxRetries && shouldRetry(method, res.StatusCode) {
+			time.Sleep(retryDelay(res.Header, attempt, time.Now()))
+			continue
+		}
+
+		if res.StatusCode >= 400 {
+			return body, errors.NewResponseError(res.StatusCode, res.Header.Get("X-Request-Id"), body)
+		}
+
+		return body, nil
+	}
+}
+
+// shouldRetry checks if a failed request can be sent again. Rate limited requests weren't
+// processed, so they're always safe to retry. Server errors are only retried for methods where
+// sending the request again won't apply a change twice.
+func shouldRetry(method string, statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return isIdempotent(method)
+	default:
+		return false
+	}
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
 
-	res, err := client.Do(req)
-	if err != nil {
-		return nil, err
+// retryDelay gets how long to wait before retrying a request. It uses the Retry-After header or
+// LaunchDarkly's X-Ratelimit-Reset header, which is when the rate limit resets in Unix
+// milliseconds, and falls back to exponential backoff.
+func retryDelay(header http.Header, attempt int, now time.Time) time.Duration {
+	if retryAfter := header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil {
+			return nonNegative(time.Duration(seconds) * time.Second)
+		}
+		if date, err := http.ParseTime(retryAfter); err == nil {
+			return nonNegative(date.Sub(now))
+		}
 	}
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
+	if reset := header.Get("X-Ratelimit-Reset"); reset != "" {
+		if ms, err := strconv.ParseInt(reset, 10, 64); err == nil {
+			return nonNegative(time.UnixMilli(ms).Sub(now))
+		}
+	}
+
+	return backoffDelay(attempt)
+}
+
+// backoffDelay doubles the delay for each attempt with jitter so clients that were rate limited at
+// the same time don't retry at the same time.
+func backoffDelay(attempt int) time.Duration {
+	delay := retryMaxDelay
+	if attempt < 16 && retryBaseDelay<<attempt < retryMaxDelay {
+		delay = retryBaseDelay << attempt
 	}
-	defer res.Body.Close()
 
-	if res.StatusCode >= 400 {
-		return body, errors.NewError(string(body))
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
 	}
 
-	return body, nil
+	return d
+}
+
+func newRequest(cliVersion, accessToken, method, path, contentType string, query url.Values, data []byte) *http.Request {
+	req, _ := http.NewRequest(method, path, bytes.NewReader(data))
+	req.Header.Add("Authorization", accessToken)
+	req.Header.Add("Content-type", contentType)
+	req.Header.Set("User-Agent", fmt.Sprintf("launchdarkly-cli/v%s", cliVersion))
+	req.URL.RawQuery = query.Encode()
+
+	return req
 }
@@ -0,0 +1,139 @@
+package resources
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ldcli/internal/errors"
+)
+
+func TestMakeRequest(t *testing.T) {
+	t.Run("retries a rate limited request", func(t *testing.T) {
+		var calls int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls < 3 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			_, _ = w.Write([]byte(`{"key": "test-key"}`))
+		}))
+		defer server.Close()
+
+		res, err := NewClient("test").MakeRequest("abcd1234", "POST", server.URL, "application/json", nil, []byte(`{}`))
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, calls)
+		assert.JSONEq(t, `{"key": "test-key"}`, string(res))
+	})
+
+	t.Run("returns the error after the max retries", func(t *testing.T) {
+		var calls int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.Header().Set("X-Ratelimit-Reset", strconv.FormatInt(time.Now().UnixMilli(), 10))
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"message": "rate limited"}`))
+		}))
+		defer server.Close()
+
+		client := NewClient("test").WithOptions(RequestOptions{MaxRetries: 2})
+		_, err := client.MakeRequest("abcd1234", "GET", server.URL, "application/json", nil, nil)
+
+		assert.EqualError(t, err, `{"message": "rate limited"}`)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("returns the status code and request ID with an error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Request-Id", "test-request-id")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code": "not_found", "message": "Unknown project"}`))
+		}))
+		defer server.Close()
+
+		_, err := NewClient("test").MakeRequest("abcd1234", "GET", server.URL, "application/json", nil, nil)
+
+		var responseErr errors.ResponseError
+		require.ErrorAs(t, err, &responseErr)
+		assert.Equal(t, http.StatusNotFound, responseErr.StatusCode)
+		assert.Equal(t, "test-request-id", responseErr.RequestID)
+	})
+
+	t.Run("does not retry a server error for a request that changes a resource", func(t *testing.T) {
+		var calls int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		_, err := NewClient("test").MakeRequest("abcd1234", "PATCH", server.URL, "application/json", nil, []byte(`[]`))
+
+		assert.Error(t, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("times out a slow request", func(t *testing.T) {
+		done := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-done
+		}))
+		defer server.Close()
+		defer close(done)
+
+		client := NewClient("test").WithOptions(RequestOptions{Timeout: 10 * time.Millisecond})
+		_, err := client.MakeRequest("abcd1234", "GET", server.URL, "application/json", nil, nil)
+
+		assert.ErrorContains(t, err, "Client.Timeout exceeded")
+	})
+}
+
+func TestRetryDelay(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		header   http.Header
+		expected time.Duration
+	}{
+		"with Retry-After seconds": {
+			header:   http.Header{"Retry-After": []string{"3"}},
+			expected: 3 * time.Second,
+		},
+		"with a Retry-After date": {
+			header:   http.Header{"Retry-After": []string{now.Add(5 * time.Second).Format(http.TimeFormat)}},
+			expected: 5 * time.Second,
+		},
+		"with X-Ratelimit-Reset": {
+			header:   http.Header{"X-Ratelimit-Reset": []string{strconv.FormatInt(now.Add(2*time.Second).UnixMilli(), 10)}},
+			expected: 2 * time.Second,
+		},
+		"with X-Ratelimit-Reset in the past": {
+			header:   http.Header{"X-Ratelimit-Reset": []string{strconv.FormatInt(now.Add(-time.Second).UnixMilli(), 10)}},
+			expected: 0,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, retryDelay(tt.header, 0, now))
+		})
+	}
+
+	t.Run("without headers uses exponential backoff with jitter", func(t *testing.T) {
+		for attempt, maxDelay := range []time.Duration{retryBaseDelay, 2 * retryBaseDelay, 4 * retryBaseDelay} {
+			delay := retryDelay(http.Header{}, attempt, now)
+
+			assert.GreaterOrEqual(t, delay, maxDelay/2)
+			assert.LessOrEqual(t, delay, maxDelay)
+		}
+		assert.LessOrEqual(t, retryDelay(http.Header{}, 100, now), retryMaxDelay)
+	})
+}
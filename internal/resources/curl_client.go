@@ -0,0 +1,60 @@
+package resources
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// CurlClient writes a curl command that makes the same request instead of sending it. The command
+// reads the access token from the LD_ACCESS_TOKEN environment variable so it can be shared.
+type CurlClient struct {
+	cliVersion string
+	out        io.Writer
+}
+
+var _ Client = CurlClient{}
+
+func NewCurlClient(cliVersion string, out io.Writer) CurlClient {
+	return CurlClient{
+		cliVersion: cliVersion,
+		out:        out,
+	}
+}
+
+func (c CurlClient) MakeRequest(accessToken, method, path, contentType string, query url.Values, data []byte) ([]byte, error) {
+	req := newRequest(c.cliVersion, accessToken, method, path, contentType, query, data)
+
+	args := []string{"curl", "-X", req.Method, shellQuote(req.URL.String())}
+
+	headerNames := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		headerNames = append(headerNames, name)
+	}
+	sort.Strings(headerNames)
+	for _, name := range headerNames {
+		if name == "Authorization" {
+			args = append(args, "-H", `"Authorization: $LD_ACCESS_TOKEN"`)
+			continue
+		}
+		for _, value := range req.Header[name] {
+			args = append(args, "-H", shellQuote(fmt.Sprintf("%s: %s", name, value)))
+		}
+	}
+
+	// requests without a body marshal it as null
+	if len(data) > 0 && string(data) != "null" {
+		args = append(args, "--data-raw", shellQuote(string(data)))
+	}
+
+	_, err := fmt.Fprintln(c.out, strings.Join(args, " "))
+
+	return nil, err
+}
+
+// shellQuote wraps the value in single quotes so a shell doesn't interpret it.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
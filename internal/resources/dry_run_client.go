@@ -0,0 +1,62 @@
+package resources
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// DryRunClient writes the request it would make instead of sending it so it can be reviewed
+// before making changes.
+type DryRunClient struct {
+	cliVersion string
+	out        io.Writer
+}
+
+var _ Client = DryRunClient{}
+
+func NewDryRunClient(cliVersion string, out io.Writer) DryRunClient {
+	return DryRunClient{
+		cliVersion: cliVersion,
+		out:        out,
+	}
+}
+
+func (c DryRunClient) MakeRequest(accessToken, method, path, contentType string, query url.Values, data []byte) ([]byte, error) {
+	req := newRequest(c.cliVersion, accessToken, method, path, contentType, query, data)
+	req.Header.Set("Authorization", "[REDACTED]")
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%s %s\n", req.Method, req.URL.String()))
+
+	headerNames := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		headerNames = append(headerNames, name)
+	}
+	sort.Strings(headerNames)
+	for _, name := range headerNames {
+		sb.WriteString(fmt.Sprintf("%s: %s\n", name, strings.Join(req.Header[name], ", ")))
+	}
+
+	// requests without a body marshal it as null
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && !bytes.Equal(trimmed, []byte("null")) {
+		var body bytes.Buffer
+		err := json.Indent(&body, trimmed, "", "  ")
+		if err != nil {
+			body.Reset()
+			body.Write(trimmed)
+		}
+		sb.WriteString("\n")
+		sb.WriteString(body.String())
+		sb.WriteString("\n")
+	}
+
+	_, err := fmt.Fprint(c.out, sb.String())
+
+	return nil, err
+}